@@ -0,0 +1,87 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/saichler/l8pollaris/go/pollaris/targets"
+)
+
+// The clause appended to an inventory query to read the elements as they were at a time, e.g.
+// "select * from NetworkDevice where Id=10.1.1.1 as of 2025-10-18 14:00". The caches only hold the
+// latest elements, so such queries are served from the history of the link's persist service, see
+// QueryService.
+const AS_OF = " as of "
+
+var asOfLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02T15:04",
+	"2006-01-02 15:04", "2006-01-02"}
+
+// AsOf returns the as of clause of the time.
+func AsOf(asOf time.Time) string {
+	return AS_OF + asOf.UTC().Format(time.RFC3339)
+}
+
+// The user defined tag clause of an inventory query, e.g. where tag.env=prod
+var tagClauseExpr = regexp.MustCompile(`(?i)\btag\.[A-Za-z0-9_-]+\s*(!=|=)`)
+
+// The group clause of an inventory query, e.g. where group=cisco-routers, selecting the members of
+// a saved query.
+var groupClauseExpr = regexp.MustCompile(`(?i)\swhere\s.*\bgroup\s*=`)
+
+// QueryService returns the service serving the inventory query of the link, the persist service for
+// an as of query or a query on the user defined tags or a group, which the caches do not index, and
+// the cache otherwise.
+func QueryService(linksId, text string) (string, byte) {
+	if strings.Contains(strings.ToLower(text), AS_OF) || tagClauseExpr.MatchString(text) ||
+		groupClauseExpr.MatchString(text) {
+		return targets.Links.Persist(linksId)
+	}
+	return targets.Links.Cache(linksId)
+}
+
+// SplitAsOf splits the as of clause from the query text, the returned time is 0 when there is none.
+func SplitAsOf(text string) (string, int64, error) {
+	index := strings.LastIndex(strings.ToLower(text), AS_OF)
+	if index == -1 {
+		return text, 0, nil
+	}
+	asOf, err := ParseTime(text[index+len(AS_OF):])
+	if err != nil {
+		return text, 0, err
+	}
+	return strings.TrimSpace(text[:index]), asOf.Unix(), nil
+}
+
+// ParseTime parses a time given in RFC3339, as a local date and time, e.g. 2025-10-18 14:00, or as
+// unix seconds.
+func ParseTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	for _, layout := range asOfLayouts {
+		t, err := time.ParseInLocation(layout, value, time.Local)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.New("Invalid time " + value + ", expected e.g. 2025-10-18 14:00")
+}
@@ -0,0 +1,80 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"sort"
+
+	"github.com/saichler/probler/go/types"
+)
+
+// Interfaces returns the interfaces of the device, the logical ones followed by the ones of the
+// physical ports, in a stable order. An interface listed in both is returned once, by its id.
+func Interfaces(device *types.NetworkDevice) []*types.Interface {
+	result := make([]*types.Interface, 0)
+	seen := make(map[string]bool)
+	add := func(interfaces []*types.Interface) {
+		for _, iface := range interfaces {
+			if iface == nil || (iface.Id != "" && seen[iface.Id]) {
+				continue
+			}
+			seen[iface.Id] = true
+			result = append(result, iface)
+		}
+	}
+	addPorts := func(ports []*types.Port) {
+		for _, port := range ports {
+			if port != nil {
+				add(port.Interfaces)
+			}
+		}
+	}
+	if device == nil {
+		return result
+	}
+	for _, key := range sortedKeys(device.Logicals) {
+		add(device.Logicals[key].Interfaces)
+	}
+	for _, key := range sortedKeys(device.Physicals) {
+		physical := device.Physicals[key]
+		addPorts(physical.Ports)
+		for _, chassis := range physical.Chassis {
+			if chassis == nil {
+				continue
+			}
+			addPorts(chassis.Ports)
+			for _, module := range chassis.Modules {
+				if module != nil {
+					addPorts(module.Ports)
+				}
+			}
+		}
+	}
+	return result
+}
+
+// sortedKeys returns the keys of the non nil elements of the map, sorted.
+func sortedKeys[V comparable](m map[string]V) []string {
+	var zero V
+	keys := make([]string, 0, len(m))
+	for key, value := range m {
+		if value != zero {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,115 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/probler/go/types"
+)
+
+const INVENTORY_REQUEST_TIMEOUT = 30
+
+// NetworkDevices fetches all the devices currently held by the network device cache.
+func NetworkDevices(vnic ifs.IVNic) ([]*types.NetworkDevice, error) {
+	return networkDevices("select * from NetworkDevice", vnic)
+}
+
+// NetworkDevice fetches a single device, by its Id, from the network device cache. The id is quoted
+// in the query, a query cannot escape a quote so an id with one is rejected.
+func NetworkDevice(id string, vnic ifs.IVNic) (*types.NetworkDevice, error) {
+	if strings.Contains(id, "'") {
+		return nil, errors.New("Invalid device id " + id)
+	}
+	list, err := networkDevices("select * from NetworkDevice where Id='"+id+"'", vnic)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.New("Device " + id + " was not found")
+	}
+	return list[0], nil
+}
+
+func networkDevices(sql string, vnic ifs.IVNic) ([]*types.NetworkDevice, error) {
+	resp, err := inventoryGet(sql, NetDev_Cache_Service_Name, NetDev_Cache_Service_Area, vnic)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := resp.(*types.NetworkDeviceList)
+	if !ok {
+		return nil, errors.New("Unexpected response type from " + NetDev_Cache_Service_Name)
+	}
+	return list.List, nil
+}
+
+// K8sClusters fetches all the kubernetes clusters currently held by the k8s cache.
+func K8sClusters(vnic ifs.IVNic) ([]*types.K8SCluster, error) {
+	resp, err := inventoryGet("select * from K8sCluster", K8s_Cache_Service_Name, K8s_Cache_Service_Area, vnic)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := resp.(*types.K8SClusterList)
+	if !ok {
+		return nil, errors.New("Unexpected response type from " + K8s_Cache_Service_Name)
+	}
+	return list.List, nil
+}
+
+// PowerDevices fetches all the PDUs and UPSes currently held by the power device cache.
+func PowerDevices(vnic ifs.IVNic) ([]*types.PowerDevice, error) {
+	resp, err := inventoryGet("select * from PowerDevice", Power_Cache_Service_Name, Power_Cache_Service_Area, vnic)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := resp.(*types.PowerDeviceList)
+	if !ok {
+		return nil, errors.New("Unexpected response type from " + Power_Cache_Service_Name)
+	}
+	return list.List, nil
+}
+
+// EnvDevices fetches all the environmental monitoring devices currently held by the env device cache.
+func EnvDevices(vnic ifs.IVNic) ([]*types.EnvDevice, error) {
+	resp, err := inventoryGet("select * from EnvDevice", Env_Cache_Service_Name, Env_Cache_Service_Area, vnic)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := resp.(*types.EnvDeviceList)
+	if !ok {
+		return nil, errors.New("Unexpected response type from " + Env_Cache_Service_Name)
+	}
+	return list.List, nil
+}
+
+func inventoryGet(sql, serviceName string, serviceArea byte, vnic ifs.IVNic) (interface{}, error) {
+	elems, err := object.NewQuery(sql, vnic.Resources())
+	if err != nil {
+		return nil, err
+	}
+	q := elems.(*object.Elements)
+	resp := vnic.Request("", serviceName, serviceArea, ifs.GET, q.PQuery(), INVENTORY_REQUEST_TIMEOUT)
+	if resp == nil {
+		return nil, errors.New("No response from " + serviceName)
+	}
+	if resp.Error() != nil {
+		return nil, resp.Error()
+	}
+	return resp.Element(), nil
+}
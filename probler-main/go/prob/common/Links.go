@@ -34,6 +34,20 @@ const (
 	K8s_Persist_Service_Area = byte(1)
 	K8s_Parser_Service_Name  = "KPars"
 	K8s_Parser_Service_Area  = byte(1)
+
+	//The power devices are polled live and not persisted, the link has no persist service
+	Power_Links_ID            = "Power"
+	Power_Cache_Service_Name  = "PwCache"
+	Power_Cache_Service_Area  = byte(2)
+	Power_Parser_Service_Name = "PwPars"
+	Power_Parser_Service_Area = byte(2)
+
+	//The environmental devices are polled live and not persisted, the link has no persist service
+	Env_Links_ID            = "Env"
+	Env_Cache_Service_Name  = "EnvCache"
+	Env_Cache_Service_Area  = byte(2)
+	Env_Parser_Service_Name = "EnvPars"
+	Env_Parser_Service_Area = byte(2)
 )
 
 type Links struct{}
@@ -48,6 +62,10 @@ func (this *Links) Parser(linkid string) (string, byte) {
 		return NetDev_Parser_Service_Name, NetDev_Parser_Service_Area
 	case K8s_Links_ID:
 		return K8s_Parser_Service_Name, K8s_Parser_Service_Area
+	case Power_Links_ID:
+		return Power_Parser_Service_Name, Power_Parser_Service_Area
+	case Env_Links_ID:
+		return Env_Parser_Service_Name, Env_Parser_Service_Area
 	}
 	return "", 0
 }
@@ -58,6 +76,10 @@ func (this *Links) Cache(linkid string) (string, byte) {
 		return NetDev_Cache_Service_Name, NetDev_Cache_Service_Area
 	case K8s_Links_ID:
 		return K8s_Cache_Service_Name, K8s_Cache_Service_Area
+	case Power_Links_ID:
+		return Power_Cache_Service_Name, Power_Cache_Service_Area
+	case Env_Links_ID:
+		return Env_Cache_Service_Name, Env_Cache_Service_Area
 	}
 	return "", 0
 }
@@ -0,0 +1,278 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// The archive version, an archive of a newer version is refused.
+	VERSION         = 1
+	FORMAT_PROTOBUF = "protobuf"
+	FORMAT_JSON     = "json"
+)
+
+// Fields holding secrets, named so or with such a suffix, e.g. snmp_community. They are cleared from
+// the exported elements, while references to secrets, e.g. secret_name, are kept.
+var secretFields = []string{"password", "passphrase", "secret", "token", "community", "private_key"}
+
+// Fields referencing a credential of the security provider, they are exported as is.
+var credentialFields = map[string]bool{"cred_id": true, "creds_id": true}
+
+// NewArchive returns an empty archive encoding its sections in the format.
+func NewArchive(format string) (*types.BackupArchive, error) {
+	if format == "" {
+		format = FORMAT_PROTOBUF
+	}
+	if format != FORMAT_PROTOBUF && format != FORMAT_JSON {
+		return nil, errors.New("Unknown archive format " + format + ", expected protobuf or json")
+	}
+	return &types.BackupArchive{Version: VERSION, Created: time.Now().Unix(), Format: format}, nil
+}
+
+// AddSection adds the list, e.g. a NetworkDeviceList, to the archive. Its inline secrets are
+// cleared and the credentials it references are added to the archive's credential ids.
+func AddSection(archive *types.BackupArchive, list proto.Message) error {
+	list = proto.Clone(list)
+	archive.Scrubbed += int32(Scrub(list))
+	ids := make(map[string]bool)
+	for _, id := range archive.CredentialIds {
+		ids[id] = true
+	}
+	CredentialIds(list, ids)
+	archive.CredentialIds = archive.CredentialIds[:0]
+	for id := range ids {
+		archive.CredentialIds = append(archive.CredentialIds, id)
+	}
+	sort.Strings(archive.CredentialIds)
+
+	section := &types.BackupSection{Type: typeName(list), Count: int32(count(list))}
+	var err error
+	if archive.Format == FORMAT_JSON {
+		var data []byte
+		data, err = protojson.Marshal(list)
+		section.Json = string(data)
+	} else {
+		section.Data, err = proto.Marshal(list)
+	}
+	if err != nil {
+		return err
+	}
+	archive.Sections = append(archive.Sections, section)
+	return nil
+}
+
+// Section decodes the archive's section of the list's type into the list, it returns false if the
+// archive has no such section.
+func Section(archive *types.BackupArchive, list proto.Message) (bool, error) {
+	for _, section := range archive.Sections {
+		if section.Type != typeName(list) {
+			continue
+		}
+		if section.Json != "" {
+			return true, protojson.Unmarshal([]byte(section.Json), list)
+		}
+		return true, proto.Unmarshal(section.Data, list)
+	}
+	return false, nil
+}
+
+// Write encodes the archive in its format and compresses it.
+func Write(archive *types.BackupArchive) ([]byte, error) {
+	var data []byte
+	var err error
+	if archive.Format == FORMAT_JSON {
+		data, err = protojson.MarshalOptions{Multiline: true}.Marshal(archive)
+	} else {
+		data, err = proto.Marshal(archive)
+	}
+	if err != nil {
+		return nil, err
+	}
+	buff := &bytes.Buffer{}
+	w := gzip.NewWriter(buff)
+	_, err = w.Write(data)
+	if err != nil {
+		return nil, err
+	}
+	err = w.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
+
+// Read decompresses and decodes an archive, of either format.
+func Read(data []byte) (*types.BackupArchive, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.New("Not a backup archive: " + err.Error())
+	}
+	data, err = io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	archive := &types.BackupArchive{}
+	if strings.HasPrefix(strings.TrimSpace(string(data[:min(len(data), 16)])), "{") {
+		err = protojson.Unmarshal(data, archive)
+	} else {
+		err = proto.Unmarshal(data, archive)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if archive.Version > VERSION || archive.Version < 1 {
+		return nil, errors.New("Unsupported archive version " + strconv.Itoa(int(archive.Version)) +
+			", expected up to " + strconv.Itoa(VERSION))
+	}
+	return archive, nil
+}
+
+// Scrub clears the secret fields of the message and its nested messages, returning their count.
+func Scrub(msg proto.Message) int {
+	scrubbed := 0
+	walk(msg.ProtoReflect(), func(m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+		if isSecret(fd) && m.Has(fd) {
+			m.Clear(fd)
+			scrubbed++
+		}
+	})
+	return scrubbed
+}
+
+// Unscrub sets the secret fields the archived element lacks from the stored one it restores, so a
+// restore keeps the secrets of the environment. It returns their count.
+func Unscrub(elem, stored proto.Message) int {
+	return unscrub(elem.ProtoReflect(), stored.ProtoReflect())
+}
+
+func unscrub(msg, stored protoreflect.Message) int {
+	count := 0
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !stored.Has(fd) {
+			continue
+		}
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil || !msg.Has(fd) {
+				continue
+			}
+			values := stored.Get(fd).Map()
+			msg.Get(fd).Map().Range(func(k protoreflect.MapKey, value protoreflect.Value) bool {
+				if values.Has(k) {
+					count += unscrub(value.Message(), values.Get(k).Message())
+				}
+				return true
+			})
+		case fd.IsList():
+			if fd.Message() == nil || !msg.Has(fd) {
+				continue
+			}
+			list, values := msg.Get(fd).List(), stored.Get(fd).List()
+			for j := 0; j < list.Len() && j < values.Len(); j++ {
+				count += unscrub(list.Get(j).Message(), values.Get(j).Message())
+			}
+		case fd.Message() != nil:
+			if msg.Has(fd) {
+				count += unscrub(msg.Get(fd).Message(), stored.Get(fd).Message())
+			}
+		case fd.Kind() == protoreflect.StringKind:
+			if isSecret(fd) && !msg.Has(fd) {
+				msg.Set(fd, stored.Get(fd))
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// CredentialIds adds the credential ids the message and its nested messages reference to the ids.
+func CredentialIds(msg proto.Message, ids map[string]bool) {
+	walk(msg.ProtoReflect(), func(m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+		if credentialFields[string(fd.Name())] && m.Get(fd).String() != "" {
+			ids[m.Get(fd).String()] = true
+		}
+	})
+}
+
+func isSecret(fd protoreflect.FieldDescriptor) bool {
+	name := string(fd.Name())
+	for _, secret := range secretFields {
+		if name == secret || strings.HasSuffix(name, "_"+secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// walk calls the visit of every singular string field of the message and its nested messages. The
+// fields are visited after the range over the message, so the visit may clear them.
+func walk(msg protoreflect.Message, visit func(protoreflect.Message, protoreflect.FieldDescriptor)) {
+	fields := make([]protoreflect.FieldDescriptor, 0)
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(k protoreflect.MapKey, value protoreflect.Value) bool {
+					walk(value.Message(), visit)
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				for i := 0; i < v.List().Len(); i++ {
+					walk(v.List().Get(i).Message(), visit)
+				}
+			}
+		case fd.Message() != nil:
+			walk(v.Message(), visit)
+		case fd.Kind() == protoreflect.StringKind:
+			fields = append(fields, fd)
+		}
+		return true
+	})
+	for _, fd := range fields {
+		visit(msg, fd)
+	}
+}
+
+func typeName(list proto.Message) string {
+	return string(list.ProtoReflect().Descriptor().Name())
+}
+
+func count(list proto.Message) int {
+	msg := list.ProtoReflect()
+	fd := msg.Descriptor().Fields().ByName("list")
+	if fd == nil || !fd.IsList() {
+		return 0
+	}
+	return msg.Get(fd).List().Len()
+}
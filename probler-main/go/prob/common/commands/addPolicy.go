@@ -0,0 +1,50 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8web/go/web/client"
+	"github.com/saichler/probler/go/services/compliance"
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// AddPolicy loads a version policy from a json file and adds, or replaces, it in the policy service.
+func AddPolicy(filename string, rc *client.RestClient, resources ifs.IResources) {
+	defer time.Sleep(time.Second)
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return
+	}
+	policy := &types.VersionPolicy{}
+	err = protojson.Unmarshal(data, policy)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return
+	}
+	_, err = rc.POST("0/"+compliance.PolicyServiceName, "VersionPolicy", "", "", policy)
+	if err != nil {
+		resources.Logger().Error(err.Error())
+		return
+	}
+	resources.Logger().Info("Added policy ", policy.Name, " Successfully")
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
 	common2 "github.com/saichler/l8types/go/ifs"
 	"github.com/saichler/l8web/go/web/client"
+	"github.com/saichler/probler/go/prob/common/creates"
 )
 
 func AddPollConfigs(rc *client.RestClient, resources common2.IResources) {
@@ -57,4 +58,33 @@ func AddPollConfigs(rc *client.RestClient, resources common2.IResources) {
 	}
 	time.Sleep(time.Second)
 
+	for _, k8sObjectPollaris := range []*l8tpollaris.L8Pollaris{creates.CreateK8sObjectPolls(), creates.CreateK8sEventPolls()} {
+		resp, err = rc.POST(strconv.Itoa(int(pollaris.ServiceArea))+"/"+pollaris.ServiceName,
+			"Pollaris", "", "", k8sObjectPollaris)
+
+		if err != nil {
+			resources.Logger().Error(err.Error())
+			return
+		}
+		_, ok = resp.(*l8tpollaris.L8Pollaris)
+		if ok {
+			resources.Logger().Info("Added ", k8sObjectPollaris.Name, " Successfully")
+		}
+		time.Sleep(time.Second)
+	}
+
+	for _, dcimPollaris := range append(creates.CreatePowerPolls(), creates.CreateEnvPolls()) {
+		resp, err = rc.POST(strconv.Itoa(int(pollaris.ServiceArea))+"/"+pollaris.ServiceName,
+			"Pollaris", "", "", dcimPollaris)
+
+		if err != nil {
+			resources.Logger().Error(err.Error())
+			return
+		}
+		_, ok = resp.(*l8tpollaris.L8Pollaris)
+		if ok {
+			resources.Logger().Info("Added ", dcimPollaris.Name, " Successfully")
+		}
+		time.Sleep(time.Second)
+	}
 }
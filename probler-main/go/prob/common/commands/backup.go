@@ -0,0 +1,198 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/saichler/l8pollaris/go/pollaris"
+	"github.com/saichler/l8pollaris/go/pollaris/targets"
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8web/go/web/client"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/prob/common/backup"
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// backupKind is a kind of the probler state in a backup, restored element by element so importing
+// the same archive twice changes nothing.
+type backupKind struct {
+	name     string
+	url      string
+	listType string
+	elemType string
+	query    string
+	newList  func() proto.Message
+	key      func(proto.Message) string
+}
+
+var backupKinds = []*backupKind{
+	{name: "targets", url: strconv.Itoa(int(targets.ServiceArea)) + "/" + targets.ServiceName,
+		listType: "L8PTargetList", elemType: "L8PTarget", query: "select * from L8PTarget",
+		newList: func() proto.Message { return &l8tpollaris.L8PTargetList{} },
+		key:     func(elem proto.Message) string { return elem.(*l8tpollaris.L8PTarget).TargetId }},
+	{name: "pollaris models", url: strconv.Itoa(int(pollaris.ServiceArea)) + "/" + pollaris.ServiceName,
+		listType: "L8PollarisList", elemType: "L8Pollaris", query: "select * from L8Pollaris",
+		newList: func() proto.Message { return &l8tpollaris.L8PollarisList{} },
+		key:     func(elem proto.Message) string { return elem.(*l8tpollaris.L8Pollaris).Name }},
+	{name: "network devices", url: strconv.Itoa(int(common.NetDev_Cache_Service_Area)) + "/" + common.NetDev_Cache_Service_Name,
+		listType: "NetworkDeviceList", elemType: "NetworkDevice", query: "select * from NetworkDevice",
+		newList: func() proto.Message { return &types.NetworkDeviceList{} },
+		key:     func(elem proto.Message) string { return elem.(*types.NetworkDevice).Id }},
+	{name: "k8s clusters", url: strconv.Itoa(int(common.K8s_Cache_Service_Area)) + "/" + common.K8s_Cache_Service_Name,
+		listType: "K8SClusterList", elemType: "K8SCluster", query: "select * from K8sCluster",
+		newList: func() proto.Message { return &types.K8SClusterList{} },
+		key:     func(elem proto.Message) string { return elem.(*types.K8SCluster).Name }},
+}
+
+// ExportBackup writes the targets, the pollaris models and the inventory to a compressed archive,
+// encoded as protobuf, the default, or as json.
+func ExportBackup(rc *client.RestClient, resources ifs.IResources, filename, format string) {
+	defer time.Sleep(time.Second)
+	if filename == "" {
+		fmt.Println("Usage: export backup <file> [protobuf|json]")
+		return
+	}
+	archive, err := backup.NewArchive(format)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return
+	}
+	for _, kind := range backupKinds {
+		list, err := kind.get(rc, resources)
+		if err != nil {
+			fmt.Println("Error: failed to export the", kind.name+":", err.Error())
+			return
+		}
+		err = backup.AddSection(archive, list)
+		if err != nil {
+			fmt.Println("Error: ", err.Error())
+			return
+		}
+		fmt.Println("Exported", archive.Sections[len(archive.Sections)-1].Count, kind.name)
+	}
+	data, err := backup.Write(archive)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return
+	}
+	err = os.WriteFile(filename, data, 0600)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return
+	}
+	if archive.Scrubbed > 0 {
+		fmt.Println("Cleared", archive.Scrubbed, "inline secrets, they are not part of the archive")
+	}
+	fmt.Println("Referenced credentials:", archive.CredentialIds)
+	fmt.Println("Exported version", archive.Version, "backup to", filename)
+}
+
+// ImportBackup restores an archive, adding the missing elements and replacing the changed ones.
+// The referenced credentials must exist in the environment, they are not part of the archive.
+func ImportBackup(rc *client.RestClient, resources ifs.IResources, filename string) {
+	defer time.Sleep(time.Second)
+	if filename == "" {
+		fmt.Println("Usage: import backup <file>")
+		return
+	}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return
+	}
+	archive, err := backup.Read(data)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return
+	}
+	fmt.Println("Importing version", archive.Version, "backup of", time.Unix(archive.Created, 0).Format(time.RFC3339))
+	fmt.Println("Referenced credentials, make sure they exist:", archive.CredentialIds)
+	for _, kind := range backupKinds {
+		list := kind.newList()
+		ok, err := backup.Section(archive, list)
+		if err != nil {
+			fmt.Println("Error: failed to read the", kind.name+":", err.Error())
+			return
+		}
+		if !ok {
+			continue
+		}
+		added, replaced, unchanged, err := kind.restore(rc, resources, list)
+		if err != nil {
+			fmt.Println("Error: failed to import the", kind.name+":", err.Error())
+			return
+		}
+		fmt.Println("Imported", kind.name+":", added, "added,", replaced, "replaced,", unchanged, "unchanged")
+	}
+}
+
+func (this *backupKind) get(rc *client.RestClient, resources ifs.IResources) (proto.Message, error) {
+	elems, err := object.NewQuery(this.query, resources)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := rc.GET(this.url, this.listType, "", "", elems.(*object.Elements).PQuery())
+	if err != nil {
+		return nil, err
+	}
+	list, ok := resp.(proto.Message)
+	if !ok || list == nil {
+		return nil, errors.New("Unexpected response from " + this.url)
+	}
+	return list, nil
+}
+
+func (this *backupKind) restore(rc *client.RestClient, resources ifs.IResources, list proto.Message) (int, int, int, error) {
+	current, err := this.get(rc, resources)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	existing := make(map[string]proto.Message)
+	for _, elem := range persist.Elements(current) {
+		existing[this.key(elem)] = elem
+	}
+	added, replaced, unchanged := 0, 0, 0
+	for _, elem := range persist.Elements(list) {
+		stored, ok := existing[this.key(elem)]
+		if ok {
+			//The archive has no inline secrets, keep the ones of the stored element
+			backup.Unscrub(elem, stored)
+		}
+		switch {
+		case !ok:
+			_, err = rc.POST(this.url, this.elemType, "", "", elem)
+			added++
+		case !proto.Equal(stored, elem):
+			_, err = rc.PUT(this.url, this.elemType, "", "", elem)
+			replaced++
+		default:
+			unchanged++
+		}
+		if err != nil {
+			return added, replaced, unchanged, err
+		}
+	}
+	return added, replaced, unchanged, nil
+}
@@ -0,0 +1,133 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8web/go/web/client"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const CHANGES_SHOWN = 100
+
+// GetChanges prints the latest changes of the device, or of all the devices, since the time.
+func GetChanges(rc *client.RestClient, resources ifs.IResources, device, since string) {
+	defer time.Sleep(time.Second)
+	query := &types.InventoryChangeQuery{Device: device, Limit: CHANGES_SHOWN}
+	if since != "" {
+		t, err := common.ParseTime(since)
+		if err != nil {
+			fmt.Println("Error: ", err.Error())
+			return
+		}
+		query.Since = t.Unix()
+	}
+	list := getChanges(rc, resources, query)
+	if list == nil {
+		return
+	}
+	for _, change := range list.List {
+		fmt.Println(time.Unix(change.Time, 0).Format("2006-01-02 15:04:05"), change.Device,
+			persist.ChangeKindName(change.Kind), change.Path, change.OldValue, "->", change.NewValue)
+	}
+	fmt.Println("Changes:", len(list.List))
+}
+
+// ExportChanges writes the whole change log to the given file, as csv or json.
+func ExportChanges(rc *client.RestClient, resources ifs.IResources, format, filename string) {
+	defer time.Sleep(time.Second)
+	if filename == "" {
+		fmt.Println("Usage: export changes <csv|json> <file>")
+		return
+	}
+	list := getChanges(rc, resources, &types.InventoryChangeQuery{})
+	if list == nil {
+		return
+	}
+	var data []byte
+	var err error
+	switch format {
+	case "csv":
+		data, err = persist.ChangesToCSV(list)
+	case "json":
+		data, err = protojson.Marshal(list)
+	default:
+		fmt.Println("Unknown export format ", format, ", expected csv or json")
+		return
+	}
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return
+	}
+	err = os.WriteFile(filename, data, 0644)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return
+	}
+	fmt.Println("Exported ", len(list.List), " changes to ", filename)
+}
+
+func getChanges(rc *client.RestClient, resources ifs.IResources, query *types.InventoryChangeQuery) *types.InventoryChangeList {
+	resp, err := rc.GET("0/"+persist.ChangeServiceName, "InventoryChangeList", "", "", query)
+	if err != nil {
+		resources.Logger().Error("Get Error:", err.Error())
+		return nil
+	}
+	list, ok := resp.(*types.InventoryChangeList)
+	if !ok {
+		fmt.Println("Unexpected response from ", persist.ChangeServiceName)
+		return nil
+	}
+	return list
+}
+
+// ChangeIgnore lists the ignore list of the change log, or adds or removes a pattern from it.
+func ChangeIgnore(rc *client.RestClient, resources ifs.IResources, action, pattern string) {
+	defer time.Sleep(time.Second)
+	var resp interface{}
+	var err error
+	ignore := &types.ChangeIgnoreList{Patterns: []string{pattern}}
+	switch {
+	case action == "" || action == "list":
+		resp, err = rc.GET("0/"+persist.ChangeServiceName, "ChangeIgnoreList", "", "", &types.ChangeIgnoreList{})
+	case action == "add" && pattern != "":
+		resp, err = rc.POST("0/"+persist.ChangeServiceName, "ChangeIgnoreList", "", "", ignore)
+	case action == "remove" && pattern != "":
+		resp, err = rc.DELETE("0/"+persist.ChangeServiceName, "ChangeIgnoreList", "", "", ignore)
+	default:
+		fmt.Println("Usage: changes ignore [list|add <pattern>|remove <pattern>]")
+		return
+	}
+	if err != nil {
+		resources.Logger().Error("Error:", err.Error())
+		return
+	}
+	list, ok := resp.(*types.ChangeIgnoreList)
+	if !ok {
+		fmt.Println("Unexpected response from ", persist.ChangeServiceName)
+		return
+	}
+	for _, pattern := range list.Patterns {
+		fmt.Println(pattern)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8web/go/web/client"
+	"github.com/saichler/probler/go/services/k8sdrift"
+	"github.com/saichler/probler/go/types"
+)
+
+// Diff prints the drift between two clusters, each given as <cluster> or <cluster>/<namespace>.
+func Diff(rc *client.RestClient, resources ifs.IResources, left, right string) {
+	defer time.Sleep(time.Second)
+	query := &types.K8SDriftQuery{}
+	query.LeftCluster, query.LeftNamespace = splitCluster(left)
+	query.RightCluster, query.RightNamespace = splitCluster(right)
+	resp, err := rc.GET("1/"+k8sdrift.ServiceName, "K8sDrift", "", "", query)
+	if err != nil {
+		resources.Logger().Error("Get Error:", err.Error())
+		return
+	}
+	drift, ok := resp.(*types.K8SDrift)
+	if !ok {
+		fmt.Println("Unexpected response from ", k8sdrift.ServiceName)
+		return
+	}
+	fmt.Println("---", drift.Left)
+	fmt.Println("+++", drift.Right)
+	for _, item := range drift.Items {
+		name := item.ObjectKind + " " + item.Name
+		switch item.Kind {
+		case types.K8SDriftKind_OnlyInLeft:
+			fmt.Println("-", name, "(only in", drift.Left+")")
+		case types.K8SDriftKind_OnlyInRight:
+			fmt.Println("+", name, "(only in", drift.Right+")")
+		default:
+			what := strings.ToLower(strings.TrimSuffix(item.Kind.String(), "Drift"))
+			fmt.Println("~", name, what+":", item.Left, "->", item.Right)
+		}
+	}
+	fmt.Println("Matching:", drift.MatchCount, " Differences:", len(drift.Items))
+}
+
+func splitCluster(value string) (string, string) {
+	index := strings.Index(value, "/")
+	if index == -1 {
+		return value, ""
+	}
+	return value[:index], value[index+1:]
+}
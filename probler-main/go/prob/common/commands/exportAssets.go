@@ -0,0 +1,71 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8web/go/web/client"
+	"github.com/saichler/probler/go/services/assets"
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ExportAssets writes the hardware asset register to the given file, as csv or json. When a group is
+// given as group:<name>, only the assets held by its devices are written.
+func ExportAssets(rc *client.RestClient, resources ifs.IResources, format, filename, group string) {
+	defer time.Sleep(time.Second)
+	if filename == "" || (group != "" && !strings.HasPrefix(group, GROUP_PREFIX)) {
+		fmt.Println("Usage: export assets <csv|json> <file> [group:<query name>]")
+		return
+	}
+	resp, err := rc.GET("0/"+assets.ServiceName, "AssetList", "", "",
+		&types.AssetQuery{Group: strings.TrimPrefix(group, GROUP_PREFIX)})
+	if err != nil {
+		resources.Logger().Error("Get Error:", err.Error())
+		return
+	}
+	list, ok := resp.(*types.AssetList)
+	if !ok {
+		fmt.Println("Unexpected response from ", assets.ServiceName)
+		return
+	}
+
+	var data []byte
+	switch format {
+	case "csv":
+		data, err = assets.ToCSV(list)
+	case "json":
+		data, err = protojson.Marshal(list)
+	default:
+		fmt.Println("Unknown export format ", format, ", expected csv or json")
+		return
+	}
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return
+	}
+	err = os.WriteFile(filename, data, 0644)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return
+	}
+	fmt.Println("Exported ", len(list.List), " assets to ", filename)
+}
@@ -27,21 +27,33 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
-func GetCluster(rc *client.RestClient, resources common2.IResources, name string) {
+// GetCluster prints the cluster, or the members of the group given as group:<name>, or them as they
+// were at the as of time when one is given.
+func GetCluster(rc *client.RestClient, resources common2.IResources, name, asOf string) {
 	defer time.Sleep(time.Second)
-	elems, e := object.NewQuery("select * from k8scluster where Name="+name, resources)
+	query, text := inventoryQuery("k8scluster", "Name", name)
+	elems, e := object.NewQuery(query, resources)
 	q := elems.(*object.Elements)
 	pq := q.PQuery()
 
-	jsn, _ := protojson.Marshal(pq)
-	fmt.Println(string(jsn))
-
 	if e != nil {
 		fmt.Println("Error: ", e.Error())
 		return
 	}
+	pq.Text = text
 
-	cs, _ := targets.Links.Cache(common.K8s_Links_ID)
+	if asOf != "" {
+		t, err := common.ParseTime(asOf)
+		if err != nil {
+			fmt.Println("Error: ", err.Error())
+			return
+		}
+		pq.Text = text + common.AsOf(t)
+	}
+	jsn, _ := protojson.Marshal(pq)
+	fmt.Println(string(jsn))
+
+	cs, _ := common.QueryService(common.K8s_Links_ID, pq.Text)
 
 	resp, err := rc.GET("1/"+cs, "K8SClusterList",
 		"", "", pq)
@@ -0,0 +1,52 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8web/go/web/client"
+	"github.com/saichler/probler/go/services/compliance"
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// GetCompliance prints the software/firmware compliance report, optionally only for a location
+// and/or the devices of a saved query.
+func GetCompliance(rc *client.RestClient, resources ifs.IResources, location, group string) {
+	defer time.Sleep(time.Second)
+	resp, err := rc.GET("0/"+compliance.ServiceName, "ComplianceReport", "", "",
+		&types.ComplianceQuery{Location: location, Group: group})
+	if err != nil {
+		resources.Logger().Error("Get Error:", err.Error())
+		return
+	}
+	report, ok := resp.(*types.ComplianceReport)
+	if !ok {
+		fmt.Println("Unexpected response from ", compliance.ServiceName)
+		return
+	}
+	jsn, err := protojson.Marshal(report)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(string(jsn))
+	fmt.Println("Compliant:", report.CompliantCount, " Upgrade Needed:", report.UpgradeNeededCount,
+		" Unsupported:", report.UnsupportedCount)
+}
@@ -17,7 +17,6 @@ package commands
 
 import (
 	"fmt"
-	"github.com/saichler/l8pollaris/go/pollaris/targets"
 	"time"
 
 	"github.com/saichler/l8srlz/go/serialize/object"
@@ -28,9 +27,12 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-func GetDevice(rc *client.RestClient, resources common2.IResources, ip string) {
+// GetDevice prints the device, or the members of the group given as group:<name>, or them as they
+// were at the as of time when one is given.
+func GetDevice(rc *client.RestClient, resources common2.IResources, ip, asOf string) {
 	defer time.Sleep(time.Second)
-	elems, e := object.NewQuery("select * from NetworkDevice where Id="+ip, resources)
+	query, text := inventoryQuery("NetworkDevice", "Id", ip)
+	elems, e := object.NewQuery(query, resources)
 	q := elems.(*object.Elements)
 	pq := q.PQuery()
 
@@ -38,11 +40,20 @@ func GetDevice(rc *client.RestClient, resources common2.IResources, ip string) {
 		fmt.Println("Error: ", e.Error())
 		return
 	}
+	pq.Text = text
+
+	if asOf != "" {
+		t, err := common.ParseTime(asOf)
+		if err != nil {
+			fmt.Println("Error: ", err.Error())
+			return
+		}
+		pq.Text = text + common.AsOf(t)
+	}
+	cs, _ := common.QueryService(common.NetworkDevice_Links_ID, pq.Text)
 	jsn, err := protojson.Marshal(pq)
 	fmt.Println(string(jsn))
 
-	cs, _ := targets.Links.Cache(common.NetworkDevice_Links_ID)
-
 	resp, err := rc.GET("0/"+cs, "NetworkDeviceList",
 		"", "", pq)
 	if err != nil {
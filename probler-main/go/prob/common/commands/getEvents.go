@@ -0,0 +1,54 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8web/go/web/client"
+	"github.com/saichler/probler/go/services/k8sevents"
+	"github.com/saichler/probler/go/types"
+)
+
+// GetEvents prints the collected warning events of a cluster, the most recent first, optionally only
+// the events linked to a pod, node or workload.
+func GetEvents(rc *client.RestClient, resources ifs.IResources, cluster, obj string) {
+	defer time.Sleep(time.Second)
+	resp, err := rc.GET("1/"+k8sevents.ServiceName, "K8sEventList", "", "",
+		&types.K8SEventQuery{Cluster: cluster, Object: obj})
+	if err != nil {
+		resources.Logger().Error("Get Error:", err.Error())
+		return
+	}
+	list, ok := resp.(*types.K8SEventList)
+	if !ok {
+		fmt.Println("Unexpected response from ", k8sevents.ServiceName)
+		return
+	}
+	fmt.Printf("%-20s %-8s %-18s %-6s %-40s %-20s %-30s %s\n", "LAST SEEN", "TYPE", "REASON", "COUNT",
+		"OBJECT", "NODE", "WORKLOAD", "MESSAGE")
+	for _, event := range list.List {
+		workload := ""
+		if event.WorkloadName != "" {
+			workload = event.WorkloadKind + "/" + event.WorkloadName
+		}
+		fmt.Printf("%-20s %-8s %-18s %-6d %-40s %-20s %-30s %s\n",
+			time.Unix(event.LastSeen, 0).Format("2006-01-02 15:04:05"), event.Type, event.Reason, event.Count,
+			event.ObjectKind+"/"+event.ObjectName, event.Node, workload, event.Message)
+	}
+}
@@ -0,0 +1,64 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/saichler/l8pollaris/go/pollaris/targets"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8web/go/web/client"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/types"
+)
+
+// GetHistory prints the change log of a device, or of a k8s cluster, with the fields each poll changed.
+func GetHistory(rc *client.RestClient, resources ifs.IResources, kind, key string) {
+	defer time.Sleep(time.Second)
+	linksId := common.NetworkDevice_Links_ID
+	if kind == "cluster" {
+		linksId = common.K8s_Links_ID
+	} else if kind != "device" {
+		fmt.Println("Expected prctl get history <device|cluster> <key>")
+		return
+	}
+	name, area := targets.Links.Persist(linksId)
+	resp, err := rc.GET(strconv.Itoa(int(area))+"/"+name, "InventoryDeltaList", "", "", &types.InventoryHistoryQuery{Key: key})
+	if err != nil {
+		resources.Logger().Error("Get Error:", err.Error())
+		return
+	}
+	list, ok := resp.(*types.InventoryDeltaList)
+	if !ok {
+		fmt.Println("Unexpected response from ", name)
+		return
+	}
+	for _, delta := range list.List {
+		when := time.Unix(delta.Time, 0).Format("2006-01-02 15:04:05")
+		switch {
+		case delta.Deleted:
+			fmt.Println(when, "deleted")
+		case delta.Snapshot && len(delta.Fields) == 0:
+			fmt.Println(when, "added")
+		default:
+			fmt.Println(when, strings.Join(delta.Fields, ", "))
+		}
+	}
+	fmt.Println("Changes:", len(list.List))
+}
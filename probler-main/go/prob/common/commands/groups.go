@@ -0,0 +1,111 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8web/go/web/client"
+	"github.com/saichler/probler/go/services/conditions"
+	"github.com/saichler/probler/go/services/groups"
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// The prefix of a saved query name in place of a key, e.g. group:core-routers
+const GROUP_PREFIX = "group:"
+
+// AddQuery loads a saved query from a json file and adds, or replaces, it in the saved query service.
+func AddQuery(filename string, rc *client.RestClient, resources ifs.IResources) {
+	defer time.Sleep(time.Second)
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return
+	}
+	query := &types.SavedQuery{}
+	err = protojson.Unmarshal(data, query)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return
+	}
+	_, err = rc.POST("0/"+groups.QueryServiceName, "SavedQuery", "", "", query)
+	if err != nil {
+		resources.Logger().Error(err.Error())
+		return
+	}
+	resources.Logger().Info("Added query ", query.Name, " Successfully")
+}
+
+// GetQueries prints the saved queries.
+func GetQueries(rc *client.RestClient, resources ifs.IResources) {
+	defer time.Sleep(time.Second)
+	resp, err := rc.GET("0/"+groups.QueryServiceName, "SavedQueryList", "", "", &types.SavedQuery{})
+	if err != nil {
+		resources.Logger().Error("Get Error:", err.Error())
+		return
+	}
+	list, ok := resp.(*types.SavedQueryList)
+	if !ok {
+		fmt.Println("Unexpected response from ", groups.QueryServiceName)
+		return
+	}
+	for _, query := range list.List {
+		fmt.Println(query.Name, types.GroupTarget_name[int32(query.Target)], len(query.Conditions), "conditions",
+			query.Description)
+	}
+	fmt.Println("Queries:", len(list.List))
+}
+
+// GetGroup prints the ids of the current members of the saved query.
+func GetGroup(rc *client.RestClient, resources ifs.IResources, name string) {
+	defer time.Sleep(time.Second)
+	if name == "" {
+		fmt.Println("Usage: get group <query name>")
+		return
+	}
+	resp, err := rc.GET("0/"+groups.ServiceName, "GroupMembers", "", "",
+		&types.GroupQuery{Name: name, IdsOnly: true})
+	if err != nil {
+		resources.Logger().Error("Get Error:", err.Error())
+		return
+	}
+	members, ok := resp.(*types.GroupMembers)
+	if !ok {
+		fmt.Println("Unexpected response from ", groups.ServiceName)
+		return
+	}
+	for _, id := range members.Ids {
+		fmt.Println(id)
+	}
+	fmt.Println("Members:", len(members.Ids))
+}
+
+// inventoryQuery returns the query of the element with the key, or of the members of the group when
+// the key is given as group:<name>, and the query text to send. The group term is not a field of
+// the element, so it is only added to the text.
+func inventoryQuery(typeName, primaryKey, key string) (string, string) {
+	query := "select * from " + typeName
+	if strings.HasPrefix(key, GROUP_PREFIX) {
+		return query, query + " where " + conditions.GROUP_FIELD + "=" + strings.TrimPrefix(key, GROUP_PREFIX)
+	}
+	query += " where " + primaryKey + "=" + key
+	return query, query
+}
@@ -0,0 +1,135 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8web/go/web/client"
+	"github.com/saichler/probler/go/services/ipam"
+	"github.com/saichler/probler/go/types"
+)
+
+// GetIpam prints the utilization of the prefixes and their addresses, optionally only the ones in a prefix.
+func GetIpam(rc *client.RestClient, resources ifs.IResources, prefix string) {
+	defer time.Sleep(time.Second)
+	report := getIpam(rc, resources, &types.IpamQuery{Prefix: prefix})
+	if report == nil {
+		return
+	}
+	for _, p := range report.Prefixes {
+		fmt.Println(p.Prefix, "used", p.Used, "reserved", p.Reserved, "of", p.Size,
+			strconv.FormatFloat(p.Utilization, 'f', 1, 64)+"%")
+		for _, address := range p.Addresses {
+			line := make([]string, 0)
+			for _, binding := range address.Bindings {
+				line = append(line, ipamBinding(binding))
+			}
+			if address.Annotation != nil {
+				if address.Annotation.Reserved {
+					line = append(line, "reserved")
+				}
+				if address.Annotation.Note != "" {
+					line = append(line, "\""+address.Annotation.Note+"\"")
+				}
+			}
+			if address.Duplicate {
+				line = append(line, "DUPLICATE")
+			}
+			fmt.Println("  ", address.Address, strings.Join(line, " "))
+		}
+	}
+	fmt.Println("Prefixes:", len(report.Prefixes), " Duplicates:", len(report.Duplicates))
+}
+
+// GetDuplicates prints the ip addresses and the macs bound on more than one device.
+func GetDuplicates(rc *client.RestClient, resources ifs.IResources) {
+	defer time.Sleep(time.Second)
+	report := getIpam(rc, resources, &types.IpamQuery{DuplicatesOnly: true})
+	if report == nil {
+		return
+	}
+	for _, duplicate := range report.Duplicates {
+		bindings := make([]string, len(duplicate.Bindings))
+		for i, binding := range duplicate.Bindings {
+			bindings[i] = ipamBinding(binding)
+		}
+		kind := strings.TrimPrefix(types.IpamDuplicateKind_name[int32(duplicate.Kind)], "IPAM_DUPLICATE_")
+		fmt.Println(kind, duplicate.Value, strings.Join(bindings, " "))
+	}
+	fmt.Println("Duplicates:", len(report.Duplicates))
+}
+
+// IpamReserve reserves the address, with an optional note.
+func IpamReserve(rc *client.RestClient, resources ifs.IResources, address, note string) {
+	defer time.Sleep(time.Second)
+	postAnnotation(rc, resources, &types.IpamAnnotation{Address: address, Reserved: true, Note: note})
+}
+
+// IpamAnnotate sets the note of the address, keeping its reservation.
+func IpamAnnotate(rc *client.RestClient, resources ifs.IResources, address, note string) {
+	defer time.Sleep(time.Second)
+	if note == "" {
+		fmt.Println("Usage: ipam annotate <address> <note>")
+		return
+	}
+	postAnnotation(rc, resources, &types.IpamAnnotation{Address: address, Note: note})
+}
+
+// IpamRelease removes the reservation and the note of the address.
+func IpamRelease(rc *client.RestClient, resources ifs.IResources, address string) {
+	defer time.Sleep(time.Second)
+	_, err := rc.DELETE("0/"+ipam.ServiceName, "IpamAnnotation", "", "", &types.IpamAnnotation{Address: address})
+	if err != nil {
+		resources.Logger().Error(err.Error())
+		return
+	}
+	resources.Logger().Info("Released ", address, " Successfully")
+}
+
+func postAnnotation(rc *client.RestClient, resources ifs.IResources, annotation *types.IpamAnnotation) {
+	_, err := rc.POST("0/"+ipam.ServiceName, "IpamAnnotation", "", "", annotation)
+	if err != nil {
+		resources.Logger().Error(err.Error())
+		return
+	}
+	resources.Logger().Info("Annotated ", annotation.Address, " Successfully")
+}
+
+func getIpam(rc *client.RestClient, resources ifs.IResources, query *types.IpamQuery) *types.IpamReport {
+	resp, err := rc.GET("0/"+ipam.ServiceName, "IpamReport", "", "", query)
+	if err != nil {
+		resources.Logger().Error("Get Error:", err.Error())
+		return nil
+	}
+	report, ok := resp.(*types.IpamReport)
+	if !ok {
+		fmt.Println("Unexpected response from ", ipam.ServiceName)
+		return nil
+	}
+	return report
+}
+
+func ipamBinding(binding *types.IpamBinding) string {
+	if binding.Management {
+		return binding.DeviceId + "/management"
+	}
+	return binding.DeviceId + "/" + binding.Interface
+}
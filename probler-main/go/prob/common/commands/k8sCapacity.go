@@ -0,0 +1,70 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8web/go/web/client"
+	"github.com/saichler/probler/go/services/k8scapacity"
+	"github.com/saichler/probler/go/types"
+)
+
+// K8sCapacity prints the node capacity of a cluster, or of all the clusters, and how many more pods of
+// the given <cpu>/<memory> size, e.g. 500m/512Mi, fit on every node.
+func K8sCapacity(rc *client.RestClient, resources ifs.IResources, cluster, podSize string) {
+	defer time.Sleep(time.Second)
+	query := &types.K8SCapacityQuery{Cluster: cluster}
+	if podSize != "" {
+		query.PodCpu, query.PodMemory, _ = strings.Cut(podSize, "/")
+	}
+	resp, err := rc.GET("1/"+k8scapacity.ServiceName, "K8sCapacityReportList", "", "", query)
+	if err != nil {
+		resources.Logger().Error("Get Error:", err.Error())
+		return
+	}
+	list, ok := resp.(*types.K8SCapacityReportList)
+	if !ok {
+		fmt.Println("Unexpected response from ", k8scapacity.ServiceName)
+		return
+	}
+	for _, report := range list.List {
+		fmt.Println("Cluster:", report.Cluster, " Pod size:", report.PodCpu+"/"+report.PodMemory)
+		fmt.Printf("  %-30s %-12s %-12s %-12s %-12s %-8s %s\n", "NODE", "CPU REQ", "CPU LIMIT", "MEM REQ",
+			"MEM LIMIT", "PODS", "FIT")
+		for _, node := range append(report.Nodes, report.Total) {
+			name := node.Name
+			if !node.Schedulable {
+				name = name + " (unschedulable)"
+			}
+			podsFit := strconv.Itoa(int(node.PodsFit))
+			if node.PodsFitUnbounded {
+				podsFit = "unbounded"
+			}
+			fmt.Printf("  %-30s %-12s %-12s %-12s %-12s %-8s %s\n", name,
+				percent(node.CpuRequestRatio), percent(node.CpuOvercommit), percent(node.MemoryRequestRatio),
+				percent(node.MemoryOvercommit), fmt.Sprintf("%d/%d", node.PodCount, node.AllocatablePods), podsFit)
+		}
+	}
+}
+
+func percent(ratio float64) string {
+	return fmt.Sprintf("%.0f%%", ratio*100)
+}
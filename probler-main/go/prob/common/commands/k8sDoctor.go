@@ -0,0 +1,59 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8web/go/web/client"
+	"github.com/saichler/probler/go/services/k8sdoctor"
+	"github.com/saichler/probler/go/types"
+)
+
+// K8sDoctor prints the problem report of a cluster, or of all the clusters, with the suggested next
+// steps of every problem.
+func K8sDoctor(rc *client.RestClient, resources ifs.IResources, cluster string) {
+	defer time.Sleep(time.Second)
+	resp, err := rc.GET("1/"+k8sdoctor.ServiceName, "K8sProblemReportList", "", "",
+		&types.K8SDoctorQuery{Cluster: cluster})
+	if err != nil {
+		resources.Logger().Error("Get Error:", err.Error())
+		return
+	}
+	list, ok := resp.(*types.K8SProblemReportList)
+	if !ok {
+		fmt.Println("Unexpected response from ", k8sdoctor.ServiceName)
+		return
+	}
+	for _, report := range list.List {
+		fmt.Println("Cluster:", report.Cluster, " Critical:", report.CriticalCount, " Warning:", report.WarningCount,
+			" Info:", report.InfoCount)
+		for _, problem := range report.Problems {
+			severity := strings.TrimPrefix(problem.Severity.String(), "Problem")
+			name := problem.ObjectKind + "/" + problem.Name
+			if problem.Namespace != "" {
+				name = problem.Namespace + "/" + name
+			}
+			fmt.Printf("  [%s] %s: %s\n", severity, name, problem.Summary)
+			for _, step := range problem.NextSteps {
+				fmt.Println("      -", step)
+			}
+		}
+	}
+}
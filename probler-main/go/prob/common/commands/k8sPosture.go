@@ -0,0 +1,64 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8web/go/web/client"
+	"github.com/saichler/probler/go/services/k8sposture"
+	"github.com/saichler/probler/go/types"
+)
+
+// K8sPosture prints the security posture findings of a cluster, or of all the clusters, optionally
+// only of a namespace, with the trend of the critical and warning findings.
+func K8sPosture(rc *client.RestClient, resources ifs.IResources, cluster, namespace string) {
+	defer time.Sleep(time.Second)
+	resp, err := rc.GET("1/"+k8sposture.ServiceName, "K8sPostureReportList", "", "",
+		&types.K8SPostureQuery{Cluster: cluster, Namespace: namespace})
+	if err != nil {
+		resources.Logger().Error("Get Error:", err.Error())
+		return
+	}
+	list, ok := resp.(*types.K8SPostureReportList)
+	if !ok {
+		fmt.Println("Unexpected response from ", k8sposture.ServiceName)
+		return
+	}
+	for _, report := range list.List {
+		fmt.Println("Cluster:", report.Cluster, " Critical:", report.CriticalCount, " Warning:", report.WarningCount,
+			" Info:", report.InfoCount)
+		for _, finding := range report.Findings {
+			severity := strings.TrimPrefix(finding.Severity.String(), "Problem")
+			name := finding.ObjectKind + "/" + finding.Name
+			if finding.Namespace != "" && finding.ObjectKind != "Namespace" {
+				name = finding.Namespace + "/" + name
+			}
+			if finding.Container != "" {
+				name = name + "[" + finding.Container + "]"
+			}
+			fmt.Printf("  [%s] %s %s: %s\n", severity, finding.Rule.String(), name, finding.Detail)
+		}
+		if len(report.Trend) > 1 {
+			first, last := report.Trend[0], report.Trend[len(report.Trend)-1]
+			fmt.Println("  Trend since", time.Unix(first.Time, 0).Format("2006-01-02 15:04"), " Critical:",
+				first.CriticalCount, "->", last.CriticalCount, " Warning:", first.WarningCount, "->", last.WarningCount)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8web/go/web/client"
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/types"
+)
+
+// SchemaPlan prints the schema changes the orm would apply to the table, or to all the tables,
+// flagging the destructive ones that wait for approval.
+func SchemaPlan(rc *client.RestClient, resources ifs.IResources, table string) {
+	defer time.Sleep(time.Second)
+	query := &types.SchemaPlanQuery{Table: table}
+	resp, err := rc.GET("0/"+persist.SchemaServiceName, "SchemaPlan", "", "", query)
+	if err != nil {
+		resources.Logger().Error("Get Error:", err.Error())
+		return
+	}
+	plan, ok := resp.(*types.SchemaPlan)
+	if !ok {
+		fmt.Println("Unexpected response from ", persist.SchemaServiceName)
+		return
+	}
+	fmt.Println("Schema version:", plan.Version)
+	if len(plan.Changes) == 0 {
+		fmt.Println("The schema is up to date")
+		return
+	}
+	for _, change := range plan.Changes {
+		mark := " "
+		if change.Destructive {
+			mark = "!"
+		}
+		fmt.Println(mark, change.Statement)
+	}
+	fmt.Println("Changes:", len(plan.Changes), " Destructive:", plan.DestructiveCount)
+	if plan.DestructiveCount > 0 {
+		fmt.Println("Destructive changes are applied on the orm restart with " + persist.ENV_DB_APPROVE_DESTRUCTIVE + "=true")
+	}
+}
@@ -0,0 +1,52 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8web/go/web/client"
+	"github.com/saichler/probler/go/services/search"
+	"github.com/saichler/probler/go/types"
+)
+
+// Search prints the ranked inventory hits of the search text.
+func Search(rc *client.RestClient, resources ifs.IResources, text string) {
+	defer time.Sleep(time.Second)
+	if text == "" {
+		fmt.Println("Usage: search <text>")
+		return
+	}
+	resp, err := rc.GET("0/"+search.ServiceName, "SearchResult", "", "", &types.SearchQuery{Text: text})
+	if err != nil {
+		resources.Logger().Error("Get Error:", err.Error())
+		return
+	}
+	result, ok := resp.(*types.SearchResult)
+	if !ok {
+		fmt.Println("Unexpected response from ", search.ServiceName)
+		return
+	}
+	for _, hit := range result.Hits {
+		kind := strings.ToLower(strings.TrimPrefix(types.SearchKind_name[int32(hit.Kind)], "SEARCH_KIND_"))
+		fmt.Println(strconv.FormatFloat(hit.Score, 'f', 0, 64), kind, hit.Id, hit.Name, hit.Field+"="+hit.Value)
+	}
+	fmt.Println("Hits:", len(result.Hits), "of", result.Total)
+}
@@ -0,0 +1,127 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8types/go/types/l8api"
+	"github.com/saichler/l8web/go/web/client"
+	"github.com/saichler/probler/go/services/tags"
+	"github.com/saichler/probler/go/types"
+)
+
+// SetTags sets the tags, e.g. env=prod,owner=netops, on the comma separated target ids or on the
+// members of a group.
+func SetTags(rc *client.RestClient, resources ifs.IResources, ids, keyValues string) {
+	defer time.Sleep(time.Second)
+	update := tagUpdate(ids)
+	if update == nil {
+		fmt.Println("Usage: tag set <ids|group:name> <key=value,...>")
+		return
+	}
+	update.Set = make(map[string]string)
+	for _, keyValue := range splitList(keyValues) {
+		key, value, ok := strings.Cut(keyValue, "=")
+		if !ok {
+			fmt.Println("Usage: tag set <ids|group:name> <key=value,...>")
+			return
+		}
+		update.Set[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	if len(update.Set) == 0 {
+		fmt.Println("Usage: tag set <ids|group:name> <key=value,...>")
+		return
+	}
+	resp, err := rc.POST("0/"+tags.ServiceName, "TagSetList", "", "", update)
+	printTagged(resources, resp, err)
+}
+
+// RemoveTags removes the comma separated tag keys, or all the tags when none are given, from the
+// comma separated target ids or from the members of a group.
+func RemoveTags(rc *client.RestClient, resources ifs.IResources, ids, keys string) {
+	defer time.Sleep(time.Second)
+	update := tagUpdate(ids)
+	if update == nil {
+		fmt.Println("Usage: tag remove <ids|group:name> [key,...]")
+		return
+	}
+	update.Remove = splitList(keys)
+	resp, err := rc.DELETE("0/"+tags.ServiceName, "TagSetList", "", "", update)
+	printTagged(resources, resp, err)
+}
+
+// GetTags prints the tagged targets, only those having the tags of the filter, e.g. env=prod, when given.
+func GetTags(rc *client.RestClient, resources ifs.IResources, filter string) {
+	defer time.Sleep(time.Second)
+	query := "select * from TagSet"
+	for i, keyValue := range splitList(filter) {
+		if i == 0 {
+			query += " where "
+		} else {
+			query += " and "
+		}
+		query += "tag." + keyValue
+	}
+	resp, err := rc.GET("0/"+tags.ServiceName, "TagSetList", "", "", &l8api.L8Query{Text: query})
+	printTagged(resources, resp, err)
+}
+
+func tagUpdate(ids string) *types.TagUpdate {
+	if ids == "" {
+		return nil
+	}
+	if strings.HasPrefix(ids, GROUP_PREFIX) {
+		return &types.TagUpdate{Group: strings.TrimPrefix(ids, GROUP_PREFIX)}
+	}
+	return &types.TagUpdate{Ids: splitList(ids)}
+}
+
+func printTagged(resources ifs.IResources, resp interface{}, err error) {
+	if err != nil {
+		resources.Logger().Error(err.Error())
+		return
+	}
+	list, ok := resp.(*types.TagSetList)
+	if !ok {
+		fmt.Println("Unexpected response from ", tags.ServiceName)
+		return
+	}
+	for _, set := range list.List {
+		keyValues := make([]string, 0, len(set.Tags))
+		for key, value := range set.Tags {
+			keyValues = append(keyValues, key+"="+value)
+		}
+		sort.Strings(keyValues)
+		fmt.Println(set.Id, strings.Join(keyValues, ","))
+	}
+	fmt.Println("Tagged:", len(list.List))
+}
+
+func splitList(text string) []string {
+	result := make([]string, 0)
+	for _, item := range strings.Split(text, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
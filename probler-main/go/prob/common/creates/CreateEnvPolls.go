@@ -0,0 +1,59 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package creates
+
+import (
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+)
+
+const (
+	ENTITY_SENSOR_MIB = ".1.3.6.1.2.1.99.1.1.1"
+	ENT_PHYSICAL_NAME = ".1.3.6.1.2.1.47.1.1.1.1.7"
+	ENV_POLLARIS      = "entity-sensor-mib"
+)
+
+// CreateEnvPolls returns the pollaris model of the environmental monitoring devices, the sensors
+// are read from the entPhySensorTable of the ENTITY-SENSOR-MIB (RFC 3433), keyed by entPhysicalIndex.
+func CreateEnvPolls() *l8tpollaris.L8Pollaris {
+	system := &l8tpollaris.L8Poll{Name: "envSystem", What: ".1.3.6.1.2.1.1", Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2,
+		Operation: l8tpollaris.L8C_Operation_L8C_Map}
+	system.Attributes = []*l8tpollaris.L8PAttribute{
+		setAttribute("envdevice.sysoid", ".1.3.6.1.2.1.1.2.0"),
+		setAttribute("envdevice.name", ".1.3.6.1.2.1.1.5.0"),
+		setAttribute("envdevice.location", ".1.3.6.1.2.1.1.6.0"),
+	}
+
+	sensors := &l8tpollaris.L8Poll{Name: "entPhySensor", What: ENTITY_SENSOR_MIB, Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2,
+		Operation: l8tpollaris.L8C_Operation_L8C_Map}
+	sensors.Attributes = []*l8tpollaris.L8PAttribute{
+		setAttribute("envdevice.sensors.sensortype", ENTITY_SENSOR_MIB+".1"),
+		setAttribute("envdevice.sensors.scale", ENTITY_SENSOR_MIB+".2"),
+		setAttribute("envdevice.sensors.precision", ENTITY_SENSOR_MIB+".3"),
+		setAttribute("envdevice.sensors.value", ENTITY_SENSOR_MIB+".4"),
+		setAttribute("envdevice.sensors.status", ENTITY_SENSOR_MIB+".5"),
+		setAttribute("envdevice.sensors.units", ENTITY_SENSOR_MIB+".6"),
+	}
+
+	//The sensor names come from the ENTITY-MIB, the entPhysicalTable shares the sensor index
+	names := &l8tpollaris.L8Poll{Name: "entPhySensorName", What: ENT_PHYSICAL_NAME, Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2,
+		Operation: l8tpollaris.L8C_Operation_L8C_Map}
+	names.Attributes = []*l8tpollaris.L8PAttribute{
+		setAttribute("envdevice.sensors.name", ENT_PHYSICAL_NAME),
+	}
+
+	return &l8tpollaris.L8Pollaris{Name: ENV_POLLARIS, Groups: []string{ENV_POLLARIS},
+		Polling: map[string]*l8tpollaris.L8Poll{system.Name: system, sensors.Name: sensors, names.Name: names}}
+}
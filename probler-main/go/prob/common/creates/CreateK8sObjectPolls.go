@@ -0,0 +1,43 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package creates
+
+import (
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/probler/go/services/k8sevents"
+	"github.com/saichler/probler/go/services/k8sobjects"
+)
+
+// CreateK8sObjectPolls returns the pollaris of the kubectl get -o json jobs, one job per collected
+// resource, named after the resource. The jobs are run on demand through the exec service.
+func CreateK8sObjectPolls() *l8tpollaris.L8Pollaris {
+	polling := make(map[string]*l8tpollaris.L8Poll)
+	for _, resource := range k8sobjects.Resources {
+		polling[resource.Name] = &l8tpollaris.L8Poll{Name: resource.Name, What: resource.Command(),
+			Protocol: l8tpollaris.L8PProtocol_L8PKubectl}
+	}
+	return &l8tpollaris.L8Pollaris{Name: k8sobjects.K8S_OBJECTS_POLLARIS, Groups: []string{k8sobjects.K8S_OBJECTS_POLLARIS},
+		Polling: polling}
+}
+
+// CreateK8sEventPolls returns the pollaris of the job tailing the warning events of a cluster.
+func CreateK8sEventPolls() *l8tpollaris.L8Pollaris {
+	events := k8sevents.WarningEvents
+	polling := map[string]*l8tpollaris.L8Poll{events.Name: {Name: events.Name, What: events.Command(),
+		Protocol: l8tpollaris.L8PProtocol_L8PKubectl}}
+	return &l8tpollaris.L8Pollaris{Name: k8sevents.K8S_EVENTS_POLLARIS, Groups: []string{k8sevents.K8S_EVENTS_POLLARIS},
+		Polling: polling}
+}
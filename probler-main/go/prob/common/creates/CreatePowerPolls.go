@@ -0,0 +1,122 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package creates
+
+import (
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+)
+
+const (
+	UPS_MIB      = ".1.3.6.1.2.1.33.1"
+	APC_RPDU2    = ".1.3.6.1.4.1.318.1.1.26"
+	UPS_POLLARIS = "ups-mib"
+	PDU_POLLARIS = "apc-rpdu2"
+)
+
+// CreatePowerPolls returns the pollaris models of the power devices, the standard UPS-MIB (RFC 1628)
+// for UPSes and the PowerNet-MIB rPDU2 branch for PDUs.
+func CreatePowerPolls() []*l8tpollaris.L8Pollaris {
+	return []*l8tpollaris.L8Pollaris{CreateUpsPolls(), CreatePduPolls()}
+}
+
+func CreateUpsPolls() *l8tpollaris.L8Pollaris {
+	ident := &l8tpollaris.L8Poll{Name: "upsIdent", What: UPS_MIB + ".1", Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2,
+		Operation: l8tpollaris.L8C_Operation_L8C_Map}
+	ident.Attributes = []*l8tpollaris.L8PAttribute{
+		setAttribute("powerdevice.info.vendor", UPS_MIB+".1.1.0"),
+		setAttribute("powerdevice.info.model", UPS_MIB+".1.2.0"),
+		setAttribute("powerdevice.info.firmwareversion", UPS_MIB+".1.3.0"),
+		setAttribute("powerdevice.info.name", UPS_MIB+".1.5.0"),
+	}
+
+	battery := &l8tpollaris.L8Poll{Name: "upsBattery", What: UPS_MIB + ".2", Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2,
+		Operation: l8tpollaris.L8C_Operation_L8C_Map}
+	battery.Attributes = []*l8tpollaris.L8PAttribute{
+		setAttribute("powerdevice.ups.batterystatus", UPS_MIB+".2.1.0"),
+		setAttribute("powerdevice.ups.secondsonbattery", UPS_MIB+".2.2.0"),
+		setAttribute("powerdevice.ups.batteryruntimeminutes", UPS_MIB+".2.3.0"),
+		setAttribute("powerdevice.ups.batterychargepercent", UPS_MIB+".2.4.0"),
+		setAttribute("powerdevice.ups.batterytemperature", UPS_MIB+".2.7.0"),
+	}
+
+	//Input and output tables are walked for their first line only
+	input := &l8tpollaris.L8Poll{Name: "upsInput", What: UPS_MIB + ".3", Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2,
+		Operation: l8tpollaris.L8C_Operation_L8C_Map}
+	input.Attributes = []*l8tpollaris.L8PAttribute{
+		setAttribute("powerdevice.ups.inputvoltage", UPS_MIB+".3.3.1.3.1"),
+		setAttribute("powerdevice.ups.inputpowerw", UPS_MIB+".3.3.1.5.1"),
+	}
+
+	output := &l8tpollaris.L8Poll{Name: "upsOutput", What: UPS_MIB + ".4", Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2,
+		Operation: l8tpollaris.L8C_Operation_L8C_Map}
+	output.Attributes = []*l8tpollaris.L8PAttribute{
+		setAttribute("powerdevice.ups.outputsource", UPS_MIB+".4.1.0"),
+		setAttribute("powerdevice.ups.outputpowerw", UPS_MIB+".4.4.1.4.1"),
+		setAttribute("powerdevice.ups.outputloadpercent", UPS_MIB+".4.4.1.5.1"),
+	}
+
+	return &l8tpollaris.L8Pollaris{Name: UPS_POLLARIS, Groups: []string{UPS_POLLARIS},
+		Polling: map[string]*l8tpollaris.L8Poll{ident.Name: ident, battery.Name: battery,
+			input.Name: input, output.Name: output}}
+}
+
+func CreatePduPolls() *l8tpollaris.L8Pollaris {
+	ident := &l8tpollaris.L8Poll{Name: "rPDU2Ident", What: APC_RPDU2 + ".2", Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2,
+		Operation: l8tpollaris.L8C_Operation_L8C_Map}
+	ident.Attributes = []*l8tpollaris.L8PAttribute{
+		setAttribute("powerdevice.info.name", APC_RPDU2+".2.1.3.1"),
+		setAttribute("powerdevice.info.firmwareversion", APC_RPDU2+".2.1.6.1"),
+		setAttribute("powerdevice.info.model", APC_RPDU2+".2.1.8.1"),
+		setAttribute("powerdevice.info.serialnumber", APC_RPDU2+".2.1.9.1"),
+	}
+
+	//rPDU2PhaseStatusTable, the current is reported in tenths of amps and the power in
+	//hundredths of kW, they are kept in these units
+	phases := &l8tpollaris.L8Poll{Name: "rPDU2Phase", What: APC_RPDU2 + ".6.3", Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2,
+		Operation: l8tpollaris.L8C_Operation_L8C_Map}
+	phases.Attributes = []*l8tpollaris.L8PAttribute{
+		setAttribute("powerdevice.pdu.circuits.currentdecia", APC_RPDU2+".6.3.1.5"),
+		setAttribute("powerdevice.pdu.circuits.voltage", APC_RPDU2+".6.3.1.6"),
+		setAttribute("powerdevice.pdu.circuits.powerdecaw", APC_RPDU2+".6.3.1.7"),
+	}
+
+	//rPDU2PhaseConfigTable, the overload threshold is used as the circuit rating.
+	//The table has no phase name, circuits are named by their index.
+	phaseConfig := &l8tpollaris.L8Poll{Name: "rPDU2PhaseConfig", What: APC_RPDU2 + ".6.1", Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2,
+		Operation: l8tpollaris.L8C_Operation_L8C_Map}
+	phaseConfig.Attributes = []*l8tpollaris.L8PAttribute{
+		setAttribute("powerdevice.pdu.circuits.ratedcurrenta", APC_RPDU2+".6.1.1.7"),
+	}
+
+	//rPDU2OutletSwitchedStatusTable & rPDU2OutletMeteredStatusTable
+	outlets := &l8tpollaris.L8Poll{Name: "rPDU2Outlet", What: APC_RPDU2 + ".9", Protocol: l8tpollaris.L8PProtocol_L8PPSNMPV2,
+		Operation: l8tpollaris.L8C_Operation_L8C_Map}
+	outlets.Attributes = []*l8tpollaris.L8PAttribute{
+		setAttribute("powerdevice.pdu.outlets.name", APC_RPDU2+".9.2.3.1.3"),
+		setAttribute("powerdevice.pdu.outlets.state", APC_RPDU2+".9.2.3.1.5"),
+		setAttribute("powerdevice.pdu.outlets.powerw", APC_RPDU2+".9.4.3.1.7"),
+	}
+
+	return &l8tpollaris.L8Pollaris{Name: PDU_POLLARIS, Groups: []string{PDU_POLLARIS},
+		Polling: map[string]*l8tpollaris.L8Poll{ident.Name: ident, phases.Name: phases,
+			phaseConfig.Name: phaseConfig, outlets.Name: outlets}}
+}
+
+func setAttribute(propertyId, from string) *l8tpollaris.L8PAttribute {
+	return &l8tpollaris.L8PAttribute{PropertyId: propertyId,
+		Rules: []*l8tpollaris.L8PRule{{Name: "Set",
+			Params: map[string]*l8tpollaris.L8PParameter{"from": {Value: from}}}}}
+}
@@ -21,6 +21,9 @@ import (
 	"github.com/saichler/l8pollaris/go/pollaris/targets"
 	"github.com/saichler/l8types/go/ifs"
 	common2 "github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/services/physicaltree"
+	"github.com/saichler/probler/go/services/search"
 	types2 "github.com/saichler/probler/go/types"
 )
 
@@ -34,14 +37,22 @@ func main() {
 	nic.WaitForConnection()
 	res.Logger().Info("Registering box service")
 
-	/*&l8services.L8ServiceLink{ZsideServiceName: common2.ORM_SERVICE, ZsideServiceArea: 0}*/
-
 	inventory.Activate(common2.NetworkDevice_Links_ID, &types2.NetworkDevice{}, &types2.NetworkDeviceList{}, nic, "Id")
 
+	//Reload the devices persisted by the orm, instead of waiting for the next poll cycle, and then
+	//forward the updates of the cache to its persist service in the orm
+	go persist.Link(common2.NetworkDevice_Links_ID, &types2.NetworkDevice{}, "Id", nic)
+
 	s, a := targets.Links.Cache(common2.NetworkDevice_Links_ID)
 	invCenter := inventory.Inventory(res, s, a)
 	invCenter.AddMetadata("Online", Online)
 
+	//Activate the services that are derived from the network device inventory
+	physicaltree.Activate(nic)
+
+	//Activate the search across the network device and the k8s inventories
+	search.Activate(nic)
+
 	common2.WaitForSignal(nic.Resources())
 }
 
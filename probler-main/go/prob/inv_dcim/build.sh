@@ -0,0 +1,4 @@
+#!/usr/bin/env bash
+set -e
+docker build --no-cache --platform=linux/amd64 -t saichler/probler-inv-dcim:latest .
+docker push saichler/probler-inv-dcim:latest
@@ -0,0 +1,55 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"github.com/saichler/l8bus/go/overlay/vnic"
+	"github.com/saichler/l8inventory/go/inv/service"
+	"github.com/saichler/l8types/go/ifs"
+	common2 "github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/environment"
+	"github.com/saichler/probler/go/services/power"
+	types2 "github.com/saichler/probler/go/types"
+)
+
+func main() {
+	res := common2.CreateResources("dcim")
+	res.Logger().Info("Starting dcim")
+	ifs.SetNetworkMode(ifs.NETWORK_K8s)
+	nic := vnic.NewVirtualNetworkInterface(res, nil)
+	nic.Start()
+	nic.WaitForConnection()
+	res.Logger().Info("Registering dcim services")
+
+	nic.Resources().Registry().RegisterEnums(types2.BatteryStatus_value)
+	nic.Resources().Registry().RegisterEnums(types2.UpsOutputSource_value)
+	nic.Resources().Registry().RegisterEnums(types2.OutletState_value)
+	nic.Resources().Registry().RegisterEnums(types2.EnvSensorType_value)
+	nic.Resources().Registry().RegisterEnums(types2.EnvSensorScale_value)
+	nic.Resources().Registry().RegisterEnums(types2.EnvSensorStatus_value)
+
+	//Activate the power device inventory service with the primary key & sample model instance
+	inventory.Activate(common2.Power_Links_ID, &types2.PowerDevice{}, &types2.PowerDeviceList{}, nic, "Id")
+
+	//Activate the environmental device inventory service with the primary key & sample model instance
+	inventory.Activate(common2.Env_Links_ID, &types2.EnvDevice{}, &types2.EnvDeviceList{}, nic, "Id")
+
+	//Activate the services that are derived from the power device inventory
+	power.Activate(nic)
+	environment.Activate(nic)
+
+	common2.WaitForSignal(nic.Resources())
+}
@@ -21,6 +21,13 @@ import (
 	"github.com/saichler/l8types/go/ifs"
 	common2 "github.com/saichler/probler/go/prob/common"
 	"github.com/saichler/probler/go/serializers"
+	"github.com/saichler/probler/go/services/k8scapacity"
+	"github.com/saichler/probler/go/services/k8sdoctor"
+	"github.com/saichler/probler/go/services/k8sdrift"
+	"github.com/saichler/probler/go/services/k8sevents"
+	"github.com/saichler/probler/go/services/k8sobjects"
+	"github.com/saichler/probler/go/services/k8sposture"
+	"github.com/saichler/probler/go/services/persist"
 	types2 "github.com/saichler/probler/go/types"
 )
 
@@ -50,17 +57,50 @@ func main() {
 		info.AddSerializer(&serializers.Restarts{})
 	}
 
+	info, err = nic.Resources().Registry().Info("K8SCount")
+	if err != nil {
+		nic.Resources().Logger().Error(err)
+	} else {
+		info.AddSerializer(&serializers.Count{})
+	}
+
+	info, err = nic.Resources().Registry().Info("K8SAge")
+	if err != nil {
+		nic.Resources().Logger().Error(err)
+	} else {
+		info.AddSerializer(&serializers.Age{})
+	}
+
 	nic.Resources().Registry().RegisterEnums(types2.K8SPodStatus_value)
 	err = nic.Resources().Introspector().Decorators().AddAlwayOverwriteDecorator("k8scluster.pods")
 	if err != nil {
 		panic("Failed to register k8s pods")
 	}
-
-	//&l8services.L8ServiceLink{ZsideServiceName: common2.ORM_SERVICE, ZsideServiceArea: 1}
+	err = nic.Resources().Introspector().Decorators().AddAlwayOverwriteDecorator("k8scluster.objects")
+	if err != nil {
+		panic("Failed to register k8s objects")
+	}
 
 	//Activate the box inventory service with the primary key & sample model instance
 	inventory.Activate(common2.K8s_Links_ID, &types2.K8SCluster{}, &types2.K8SClusterList{}, nic, "Name")
 
+	//Reload the clusters persisted by the orm, instead of waiting for the next poll cycle, and then
+	//forward the updates of the cache to its persist service in the orm
+	go persist.Link(common2.K8s_Links_ID, &types2.K8SCluster{}, "Name", nic)
+
+	//Activate the collection of the full API objects of the clusters
+	k8sobjects.Activate(nic)
+	//Activate the warning events tail of the clusters
+	k8sevents.Activate(nic)
+	//Activate the workload health analyzer
+	k8sdoctor.Activate(nic)
+	//Activate the security posture scanner
+	k8sposture.Activate(nic)
+	//Activate the comparison of clusters
+	k8sdrift.Activate(nic)
+	//Activate the node capacity report
+	k8scapacity.Activate(nic)
+
 	if err != nil {
 		res.Logger().Error(err)
 	}
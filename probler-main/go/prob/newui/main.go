@@ -87,8 +87,67 @@ func createVnic(vnet uint32) ifs.IVNic {
 	nic.Resources().Registry().Register(&l8tpollaris.L8PTargetList{})
 	nic.Resources().Registry().Register(&types.NetworkDevice{})
 	nic.Resources().Registry().Register(&types.NetworkDeviceList{})
+	nic.Resources().Registry().Register(&types.PhysicalTreeQuery{})
+	nic.Resources().Registry().Register(&types.PhysicalTreeList{})
+	nic.Resources().Registry().Register(&types.AssetQuery{})
+	nic.Resources().Registry().Register(&types.AssetList{})
+	nic.Resources().Registry().Register(&types.VersionPolicy{})
+	nic.Resources().Registry().Register(&types.VersionPolicyList{})
+	nic.Resources().Registry().Register(&types.ComplianceQuery{})
+	nic.Resources().Registry().Register(&types.ComplianceReport{})
+	nic.Resources().Registry().Register(&types.Site{})
+	nic.Resources().Registry().Register(&types.SiteList{})
+	nic.Resources().Registry().Register(&types.SiteSummaryQuery{})
+	nic.Resources().Registry().Register(&types.SiteSummaryList{})
+	nic.Resources().Registry().Register(&types.RackRow{})
+	nic.Resources().Registry().Register(&types.RackRowList{})
+	nic.Resources().Registry().Register(&types.Rack{})
+	nic.Resources().Registry().Register(&types.RackList{})
+	nic.Resources().Registry().Register(&types.RackElevationQuery{})
+	nic.Resources().Registry().Register(&types.RackElevation{})
+	nic.Resources().Registry().Register(&types.PowerDevice{})
+	nic.Resources().Registry().Register(&types.PowerDeviceList{})
+	nic.Resources().Registry().Register(&types.PowerSummaryQuery{})
+	nic.Resources().Registry().Register(&types.PowerSummary{})
+	nic.Resources().Registry().Register(&types.EnvDevice{})
+	nic.Resources().Registry().Register(&types.EnvDeviceList{})
+	nic.Resources().Registry().Register(&types.EnvSensorBinding{})
+	nic.Resources().Registry().Register(&types.EnvSensorBindingList{})
+	nic.Resources().Registry().Register(&types.HeatMapQuery{})
+	nic.Resources().Registry().Register(&types.HeatMap{})
 	nic.Resources().Registry().Register(&types2.K8SCluster{})
 	nic.Resources().Registry().Register(&types2.K8SClusterList{})
+	nic.Resources().Registry().Register(&types2.K8SContainerQuery{})
+	nic.Resources().Registry().Register(&types2.K8SContainerList{})
+	nic.Resources().Registry().Register(&types2.K8SEventQuery{})
+	nic.Resources().Registry().Register(&types2.K8SEventList{})
+	nic.Resources().Registry().Register(&types2.K8SDoctorQuery{})
+	nic.Resources().Registry().Register(&types2.K8SProblemReportList{})
+	nic.Resources().Registry().Register(&types2.K8SPostureQuery{})
+	nic.Resources().Registry().Register(&types2.K8SPostureReportList{})
+	nic.Resources().Registry().Register(&types2.K8SDriftQuery{})
+	nic.Resources().Registry().Register(&types2.K8SDrift{})
+	nic.Resources().Registry().Register(&types2.K8SCapacityQuery{})
+	nic.Resources().Registry().Register(&types2.K8SCapacityReportList{})
+	nic.Resources().Registry().Register(&types2.SchemaPlanQuery{})
+	nic.Resources().Registry().Register(&types2.SchemaPlan{})
+	nic.Resources().Registry().Register(&types2.InventoryHistoryQuery{})
+	nic.Resources().Registry().Register(&types2.InventoryDeltaList{})
+	nic.Resources().Registry().Register(&types2.InventoryChangeQuery{})
+	nic.Resources().Registry().Register(&types2.InventoryChangeList{})
+	nic.Resources().Registry().Register(&types2.ChangeIgnoreList{})
+	nic.Resources().Registry().Register(&types2.SavedQuery{})
+	nic.Resources().Registry().Register(&types2.SavedQueryList{})
+	nic.Resources().Registry().Register(&types2.GroupQuery{})
+	nic.Resources().Registry().Register(&types2.GroupMembers{})
+	nic.Resources().Registry().Register(&types2.TagSet{})
+	nic.Resources().Registry().Register(&types2.TagSetList{})
+	nic.Resources().Registry().Register(&types2.TagUpdate{})
+	nic.Resources().Registry().Register(&types2.SearchQuery{})
+	nic.Resources().Registry().Register(&types2.SearchResult{})
+	nic.Resources().Registry().Register(&types2.IpamAnnotation{})
+	nic.Resources().Registry().Register(&types2.IpamQuery{})
+	nic.Resources().Registry().Register(&types2.IpamReport{})
 	nic.Resources().Registry().Register(&l8api.L8Query{})
 	nic.Resources().Registry().Register(&l8health.L8Top{})
 	nic.Resources().Registry().Register(&l8web.L8Empty{})
@@ -56,7 +56,7 @@ function initNodesTable(clusterName, nodes) {
         columns: [
             { key: 'name', label: 'NAME', filterKey: 'name' },
             { key: 'roles', label: 'ROLES', filterKey: 'roles' },
-            { key: 'age', label: 'AGE' },
+            { key: 'age', label: 'AGE', formatter: (value) => formatK8sAge(value) },
             { key: 'version', label: 'VERSION', filterKey: 'version' },
             { key: 'internalIp', label: 'INTERNAL-IP', filterKey: 'internalIp' },
             { key: 'externalIp', label: 'EXTERNAL-IP', filterKey: 'externalIp' },
@@ -80,21 +80,7 @@ function initPodsTable(clusterName, pods) {
             { key: 'name', label: 'NAME', filterKey: 'name' },
             {
                 key: 'ready', label: 'READY',
-                formatter: (value, row) => {
-                    let count, outof;
-                    if (value && typeof value === 'object') {
-                        count = value.count || 0;
-                        outof = value.outof || 0;
-                    } else if (typeof value === 'string') {
-                        const parts = value.split('/');
-                        count = parseInt(parts[0]) || 0;
-                        outof = parseInt(parts[1]) || 0;
-                    } else {
-                        count = 0; outof = 0;
-                    }
-                    const statusClass = count === outof ? 'status-operational' : count > 0 ? 'status-warning' : 'status-critical';
-                    return `<span class="status-badge ${statusClass}">${count}/${outof}</span>`;
-                }
+                formatter: (value) => formatK8sReady(value)
             },
             {
                 key: 'status', label: 'STATUS', filterKey: 'status',
@@ -113,7 +99,7 @@ function initPodsTable(clusterName, pods) {
                     return `<span>${value}</span>`;
                 }
             },
-            { key: 'age', label: 'AGE' },
+            { key: 'age', label: 'AGE', formatter: (value) => formatK8sAge(value) },
             { key: 'ip', label: 'IP', filterKey: 'ip' },
             { key: 'node', label: 'NODE', filterKey: 'node' },
             { key: 'nominatedNode', label: 'NOMINATED NODE', filterKey: 'nominatedNode' },
@@ -133,10 +119,10 @@ function initDeploymentsTable(clusterName, deployments) {
         columns: [
             { key: 'namespace', label: 'NAMESPACE', filterKey: 'namespace' },
             { key: 'name', label: 'NAME', filterKey: 'name' },
-            { key: 'ready', label: 'READY' },
-            { key: 'upToDate', label: 'UP-TO-DATE' },
-            { key: 'available', label: 'AVAILABLE' },
-            { key: 'age', label: 'AGE' },
+            { key: 'ready', label: 'READY', formatter: (value) => formatK8sReady(value) },
+            { key: 'upToDate', label: 'UP-TO-DATE', formatter: (value) => k8sCount(value) },
+            { key: 'available', label: 'AVAILABLE', formatter: (value) => k8sCount(value) },
+            { key: 'age', label: 'AGE', formatter: (value) => formatK8sAge(value) },
             { key: 'containers', label: 'CONTAINERS', filterKey: 'containers' },
             { key: 'images', label: 'IMAGES', filterKey: 'images' },
             { key: 'selector', label: 'SELECTOR', filterKey: 'selector' }
@@ -155,8 +141,8 @@ function initStatefulSetsTable(clusterName, statefulsets) {
         columns: [
             { key: 'namespace', label: 'NAMESPACE', filterKey: 'namespace' },
             { key: 'name', label: 'NAME', filterKey: 'name' },
-            { key: 'ready', label: 'READY' },
-            { key: 'age', label: 'AGE' },
+            { key: 'ready', label: 'READY', formatter: (value) => formatK8sReady(value) },
+            { key: 'age', label: 'AGE', formatter: (value) => formatK8sAge(value) },
             { key: 'containers', label: 'CONTAINERS', filterKey: 'containers' },
             { key: 'images', label: 'IMAGES', filterKey: 'images' }
         ],
@@ -174,13 +160,13 @@ function initDaemonSetsTable(clusterName, daemonsets) {
         columns: [
             { key: 'namespace', label: 'NAMESPACE', filterKey: 'namespace' },
             { key: 'name', label: 'NAME', filterKey: 'name' },
-            { key: 'desired', label: 'DESIRED' },
-            { key: 'current', label: 'CURRENT' },
-            { key: 'ready', label: 'READY' },
-            { key: 'upToDate', label: 'UP-TO-DATE' },
-            { key: 'available', label: 'AVAILABLE' },
+            { key: 'desired', label: 'DESIRED', formatter: (value) => k8sCount(value) },
+            { key: 'current', label: 'CURRENT', formatter: (value) => k8sCount(value) },
+            { key: 'ready', label: 'READY', formatter: (value) => k8sCount(value) },
+            { key: 'upToDate', label: 'UP-TO-DATE', formatter: (value) => k8sCount(value) },
+            { key: 'available', label: 'AVAILABLE', formatter: (value) => k8sCount(value) },
             { key: 'nodeSelector', label: 'NODE SELECTOR', filterKey: 'nodeSelector' },
-            { key: 'age', label: 'AGE' },
+            { key: 'age', label: 'AGE', formatter: (value) => formatK8sAge(value) },
             { key: 'containers', label: 'CONTAINERS', filterKey: 'containers' },
             { key: 'images', label: 'IMAGES', filterKey: 'images' },
             { key: 'selector', label: 'SELECTOR', filterKey: 'selector' }
@@ -203,7 +189,7 @@ function initServicesTable(clusterName, services) {
             { key: 'clusterIp', label: 'CLUSTER-IP', filterKey: 'clusterIp' },
             { key: 'externalIp', label: 'EXTERNAL-IP', filterKey: 'externalIp' },
             { key: 'ports', label: 'PORT(S)', filterKey: 'ports' },
-            { key: 'age', label: 'AGE' },
+            { key: 'age', label: 'AGE', formatter: (value) => formatK8sAge(value) },
             { key: 'selector', label: 'SELECTOR', filterKey: 'selector' }
         ],
         data: services,
@@ -220,7 +206,7 @@ function initNamespacesTable(clusterName, namespaces) {
         columns: [
             { key: 'name', label: 'NAME', filterKey: 'name' },
             { key: 'status', label: 'STATUS', filterKey: 'status' },
-            { key: 'age', label: 'AGE' }
+            { key: 'age', label: 'AGE', formatter: (value) => formatK8sAge(value) }
         ],
         data: namespaces,
         rowsPerPage: 15,
@@ -237,7 +223,7 @@ function initNetworkPoliciesTable(clusterName, networkpolicies) {
             { key: 'namespace', label: 'NAMESPACE', filterKey: 'namespace' },
             { key: 'name', label: 'NAME', filterKey: 'name' },
             { key: 'podSelector', label: 'POD-SELECTOR', filterKey: 'podSelector' },
-            { key: 'age', label: 'AGE' }
+            { key: 'age', label: 'AGE', formatter: (value) => formatK8sAge(value) }
         ],
         data: networkpolicies,
         rowsPerPage: 15,
@@ -175,13 +175,13 @@ function generateDaemonSetDetails(daemonset, cluster) {
             }
         },
         status: {
-            currentNumberScheduled: daemonset.current,
-            desiredNumberScheduled: daemonset.desired,
-            numberAvailable: daemonset.available,
+            currentNumberScheduled: k8sCount(daemonset.current),
+            desiredNumberScheduled: k8sCount(daemonset.desired),
+            numberAvailable: k8sCount(daemonset.available),
             numberMisscheduled: 0,
-            numberReady: daemonset.ready,
+            numberReady: k8sCount(daemonset.ready),
             observedGeneration: 1,
-            updatedNumberScheduled: daemonset.upToDate
+            updatedNumberScheduled: k8sCount(daemonset.upToDate)
         }
     };
 }
@@ -5,9 +5,9 @@ function generateDeploymentDetails(deployment, cluster) {
     const creationTime = new Date(Date.now() - Math.random() * 7 * 24 * 60 * 60 * 1000);
     const updateTime = new Date(creationTime.getTime() + Math.random() * 60000);
 
-    const readyParts = deployment.ready.split('/');
-    const readyReplicas = parseInt(readyParts[0]);
-    const totalReplicas = parseInt(readyParts[1]);
+    const ready = k8sReady(deployment.ready);
+    const readyReplicas = ready.count;
+    const totalReplicas = ready.outof;
 
     return {
         apiVersion: "apps/v1",
@@ -94,7 +94,7 @@ async function showNodeDetailModal(node, cluster) {
                     </div>
                     <div class="detail-item">
                         <span class="detail-label">Age:</span>
-                        <span class="detail-value">${node.age}</span>
+                        <span class="detail-value">${formatK8sAge(node.age)}</span>
                     </div>
                     <div class="detail-item">
                         <span class="detail-label">Cluster:</span>
@@ -5,9 +5,9 @@ function generatePodDetails(pod, cluster) {
     const creationTime = new Date(Date.now() - Math.random() * 7 * 24 * 60 * 60 * 1000);
     const startTime = new Date(creationTime.getTime() + Math.random() * 60000);
 
-    const containers = pod.ready ? pod.ready.split('/') : ['1', '1'];
-    const readyCount = parseInt(containers[0]) || 1;
-    const totalCount = parseInt(containers[1]) || 1;
+    const ready = k8sReady(pod.ready);
+    const readyCount = ready.count || 1;
+    const totalCount = ready.outof || 1;
 
     return {
         apiVersion: "v1",
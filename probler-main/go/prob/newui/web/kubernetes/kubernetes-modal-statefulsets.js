@@ -128,9 +128,9 @@ function showStatefulSetDetailModal(statefulset, cluster) {
 function generateStatefulSetDetails(statefulset, cluster) {
     const creationTime = new Date(Date.now() - Math.random() * 7 * 24 * 60 * 60 * 1000);
 
-    const readyParts = statefulset.ready.split('/');
-    const readyReplicas = parseInt(readyParts[0]);
-    const totalReplicas = parseInt(readyParts[1]);
+    const ready = k8sReady(statefulset.ready);
+    const readyReplicas = ready.count;
+    const totalReplicas = ready.outof;
 
     return {
         apiVersion: "apps/v1",
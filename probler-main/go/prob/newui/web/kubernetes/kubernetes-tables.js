@@ -45,6 +45,39 @@ function getPodStatusClass(statusText) {
     return 'status-warning';
 }
 
+// Typed k8s fields are objects, K8sAge {age, created}, K8sCount {count} & K8sReadyState {count, outof}.
+// Zero values are omitted from the json so missing fields default to 0.
+function formatK8sAge(value) {
+    if (value && typeof value === 'object') {
+        return value.age || '';
+    }
+    return value || '';
+}
+
+function k8sCount(value) {
+    if (value && typeof value === 'object') {
+        return value.count || 0;
+    }
+    return parseInt(value) || 0;
+}
+
+function k8sReady(value) {
+    if (value && typeof value === 'object') {
+        return { count: value.count || 0, outof: value.outof || 0 };
+    }
+    if (typeof value === 'string') {
+        const parts = value.split('/');
+        return { count: parseInt(parts[0]) || 0, outof: parseInt(parts[1]) || 0 };
+    }
+    return { count: 0, outof: 0 };
+}
+
+function formatK8sReady(value) {
+    const ready = k8sReady(value);
+    const statusClass = ready.count === ready.outof ? 'status-operational' : ready.count > 0 ? 'status-warning' : 'status-critical';
+    return `<span class="status-badge ${statusClass}">${ready.count}/${ready.outof}</span>`;
+}
+
 // Table Initialization Functions
 function initializePodsTable(cluster) {
     const pods = [];
@@ -16,13 +16,22 @@
 package main
 
 import (
-	"fmt"
 	"github.com/saichler/l8bus/go/overlay/vnic"
 	"github.com/saichler/l8pollaris/go/pollaris/targets"
 	"github.com/saichler/l8types/go/ifs"
 	"github.com/saichler/probler/go/prob/common"
-	"os/exec"
-	"time"
+	"github.com/saichler/probler/go/services/assets"
+	"github.com/saichler/probler/go/services/compliance"
+	"github.com/saichler/probler/go/services/environment"
+	"github.com/saichler/probler/go/services/groups"
+	"github.com/saichler/probler/go/services/ipam"
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/services/racks"
+	"github.com/saichler/probler/go/services/sites"
+	"github.com/saichler/probler/go/services/tags"
+	"github.com/saichler/probler/go/types"
+
+	_ "github.com/lib/pq"
 )
 
 func main() {
@@ -32,11 +41,48 @@ func main() {
 	nic.Start()
 	nic.WaitForConnection()
 
-	//Start postgres
-	startDb(nic)
+	//Start postgres, or connect to the external one, and migrate its schema
+	database, err := persist.Start(common.DB_CREDS, common.DB_NAME, res)
+	if err != nil {
+		panic(err.Error())
+	}
 
 	//Activate targets
 	targets.Activate(common.DB_CREDS, common.DB_NAME, nic)
+
+	//Activate the change audit log of the network devices, fed by their persist service
+	persist.ActivateChanges(database.DB, nic)
+
+	//Activate the persist services of the inventory caches
+	persist.Activate(common.NetworkDevice_Links_ID, &types.NetworkDevice{}, &types.NetworkDeviceList{}, "Id", database.DB, nic)
+	persist.Activate(common.K8s_Links_ID, &types.K8SCluster{}, &types.K8SClusterList{}, "Name", database.DB, nic)
+
+	//Activate the saved queries and the dynamic groups they define
+	groups.Activate(database.DB, nic)
+
+	//Activate the user defined tags of the targets and the network devices
+	tags.Activate(database.DB, nic)
+
+	//Activate the address management view and its reservations
+	ipam.Activate(database.DB, nic)
+
+	//Activate the sites and the site map of the network devices
+	sites.Activate(database.DB, nic)
+
+	//Activate the hardware asset register of the network devices
+	assets.Activate(database.DB, nic)
+
+	//Activate the version policies and the compliance report of the network devices
+	compliance.Activate(database.DB, nic)
+
+	//Activate the physical placement (DCIM) services
+	racks.Activate(database.DB, nic)
+
+	//Activate the rack and room bindings of the environmental sensors
+	environment.ActivateBindings(database.DB, nic)
+
+	//Activate the schema plan of the model migrations
+	persist.ActivateSchema(database.DB, nic)
 	/*
 		ts, _ := targets.Targets(nic)
 		deviceList := &l8tpollaris.L8PTargetList{}
@@ -50,19 +96,5 @@ func main() {
 		ts.Post(object.New(nil, cluster), nic)
 	*/
 	common.WaitForSignal(res)
-}
-
-func startDb(nic ifs.IVNic) {
-	_, user, pass, _, err := nic.Resources().Security().Credential(common.DB_CREDS, common.DB_NAME, nic.Resources())
-	if err != nil {
-		panic(common.DB_CREDS + " " + err.Error())
-	}
-	fmt.Println("/start-postgres.sh", common.DB_NAME, user, pass)
-	cmd := exec.Command("nohup", "/start-postgres.sh", common.DB_NAME, user, pass)
-	out, err := cmd.Output()
-	if err != nil {
-		panic(err)
-	}
-	fmt.Println(string(out))
-	time.Sleep(time.Second * 5)
+	database.Stop()
 }
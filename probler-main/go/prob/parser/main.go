@@ -34,6 +34,8 @@ func main() {
 
 	nic.Resources().Registry().Register(&types3.K8SReadyState{})
 	nic.Resources().Registry().Register(&types3.K8SRestartsState{})
+	nic.Resources().Registry().Register(&types3.K8SCount{})
+	nic.Resources().Registry().Register(&types3.K8SAge{})
 
 	info, err := nic.Resources().Registry().Info("K8SReadyState")
 	if err != nil {
@@ -49,7 +51,27 @@ func main() {
 		info.AddSerializer(&serializers.Restarts{})
 	}
 
+	info, err = nic.Resources().Registry().Info("K8SCount")
+	if err != nil {
+		nic.Resources().Logger().Error(err)
+	} else {
+		info.AddSerializer(&serializers.Count{})
+	}
+
+	info, err = nic.Resources().Registry().Info("K8SAge")
+	if err != nil {
+		nic.Resources().Logger().Error(err)
+	} else {
+		info.AddSerializer(&serializers.Age{})
+	}
+
 	nic.Resources().Registry().RegisterEnums(types3.K8SPodStatus_value)
+	nic.Resources().Registry().RegisterEnums(types3.BatteryStatus_value)
+	nic.Resources().Registry().RegisterEnums(types3.UpsOutputSource_value)
+	nic.Resources().Registry().RegisterEnums(types3.OutletState_value)
+	nic.Resources().Registry().RegisterEnums(types3.EnvSensorType_value)
+	nic.Resources().Registry().RegisterEnums(types3.EnvSensorScale_value)
+	nic.Resources().Registry().RegisterEnums(types3.EnvSensorStatus_value)
 
 	//Activate Polaris
 	pollaris.Activate(nic)
@@ -60,5 +82,11 @@ func main() {
 	//Activate Kubernetes parser
 	service.Activate(common2.K8s_Links_ID, &types3.K8SCluster{}, false, nic, "Name")
 
+	//Activate Power devices parser
+	service.Activate(common2.Power_Links_ID, &types3.PowerDevice{}, false, nic, "Id")
+
+	//Activate Environmental devices parser
+	service.Activate(common2.Env_Links_ID, &types3.EnvDevice{}, false, nic, "Id")
+
 	common2.WaitForSignal(resources)
 }
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"github.com/saichler/probler/go/prob/common/commands"
 	"os"
+	"strings"
 
 	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
 	"github.com/saichler/l8types/go/types/l8api"
@@ -36,6 +37,7 @@ func main() {
 	var cmd2 string
 	var cmd3 string
 	var cmd4 string
+	var cmd5 string
 
 	if len(os.Args) > 1 {
 		host = os.Args[1]
@@ -52,6 +54,9 @@ func main() {
 	if len(os.Args) > 5 {
 		cmd4 = os.Args[5]
 	}
+	if len(os.Args) > 6 {
+		cmd5 = os.Args[6]
+	}
 	clientConfig := &client.RestClientConfig{
 		Host:          host,
 		Port:          2443,
@@ -72,6 +77,7 @@ func main() {
 
 	resources := common.CreateResources("client")
 	resources.Introspector().Inspect(&l8tpollaris.L8Pollaris{})
+	resources.Introspector().Inspect(&l8tpollaris.L8PollarisList{})
 	resources.Introspector().Inspect(&l8tpollaris.L8PTarget{})
 	resources.Introspector().Inspect(&l8tpollaris.L8PTargetList{})
 	resources.Introspector().Inspect(&l8health.L8Health{})
@@ -80,6 +86,42 @@ func main() {
 	resources.Introspector().Inspect(&types3.K8SClusterList{})
 	resources.Introspector().Inspect(&types5.NetworkDevice{})
 	resources.Introspector().Inspect(&types5.NetworkDeviceList{})
+	resources.Introspector().Inspect(&types5.AssetQuery{})
+	resources.Introspector().Inspect(&types5.AssetList{})
+	resources.Introspector().Inspect(&types5.VersionPolicy{})
+	resources.Introspector().Inspect(&types5.VersionPolicyList{})
+	resources.Introspector().Inspect(&types5.ComplianceQuery{})
+	resources.Introspector().Inspect(&types5.ComplianceReport{})
+	resources.Introspector().Inspect(&types5.EnvSensorBinding{})
+	resources.Introspector().Inspect(&types5.K8SEventQuery{})
+	resources.Introspector().Inspect(&types5.K8SEventList{})
+	resources.Introspector().Inspect(&types5.K8SDoctorQuery{})
+	resources.Introspector().Inspect(&types5.K8SProblemReportList{})
+	resources.Introspector().Inspect(&types5.K8SPostureQuery{})
+	resources.Introspector().Inspect(&types5.K8SPostureReportList{})
+	resources.Introspector().Inspect(&types5.K8SDriftQuery{})
+	resources.Introspector().Inspect(&types5.K8SDrift{})
+	resources.Introspector().Inspect(&types5.K8SCapacityQuery{})
+	resources.Introspector().Inspect(&types5.K8SCapacityReportList{})
+	resources.Introspector().Inspect(&types5.SchemaPlanQuery{})
+	resources.Introspector().Inspect(&types5.SchemaPlan{})
+	resources.Introspector().Inspect(&types5.InventoryHistoryQuery{})
+	resources.Introspector().Inspect(&types5.InventoryDeltaList{})
+	resources.Introspector().Inspect(&types5.InventoryChangeQuery{})
+	resources.Introspector().Inspect(&types5.InventoryChangeList{})
+	resources.Introspector().Inspect(&types5.ChangeIgnoreList{})
+	resources.Introspector().Inspect(&types5.SavedQuery{})
+	resources.Introspector().Inspect(&types5.SavedQueryList{})
+	resources.Introspector().Inspect(&types5.GroupQuery{})
+	resources.Introspector().Inspect(&types5.GroupMembers{})
+	resources.Introspector().Inspect(&types5.TagSet{})
+	resources.Introspector().Inspect(&types5.TagSetList{})
+	resources.Introspector().Inspect(&types5.TagUpdate{})
+	resources.Introspector().Inspect(&types5.SearchQuery{})
+	resources.Introspector().Inspect(&types5.SearchResult{})
+	resources.Introspector().Inspect(&types5.IpamAnnotation{})
+	resources.Introspector().Inspect(&types5.IpamQuery{})
+	resources.Introspector().Inspect(&types5.IpamReport{})
 	resources.Introspector().Inspect(&l8web.L8Empty{})
 	resources.Introspector().Inspect(&l8api.L8Query{})
 	resources.Introspector().Inspect(&l8api.AuthToken{})
@@ -102,10 +144,10 @@ func main() {
 			//commands.GetTopo(cmd3, rc, resources)
 			return
 		} else if cmd2 == "cluster" {
-			commands.GetCluster(rc, resources, cmd3)
+			commands.GetCluster(rc, resources, cmd3, cmd4)
 			return
 		} else if cmd2 == "device" {
-			commands.GetDevice(rc, resources, cmd3)
+			commands.GetDevice(rc, resources, cmd3, cmd4)
 			return
 		} else if cmd2 == "ocluster" {
 			commands.GetClusterOrm(rc, resources, cmd3)
@@ -119,6 +161,86 @@ func main() {
 		} else if cmd2 == "health" {
 			commands.GetHealth(rc, resources)
 			return
+		} else if cmd2 == "compliance" {
+			commands.GetCompliance(rc, resources, cmd3, cmd4)
+			return
+		} else if cmd2 == "events" {
+			commands.GetEvents(rc, resources, cmd3, cmd4)
+			return
+		} else if cmd2 == "history" {
+			commands.GetHistory(rc, resources, cmd3, cmd4)
+			return
+		} else if cmd2 == "changes" {
+			commands.GetChanges(rc, resources, cmd3, cmd4)
+			return
+		} else if cmd2 == "queries" {
+			commands.GetQueries(rc, resources)
+			return
+		} else if cmd2 == "group" {
+			commands.GetGroup(rc, resources, cmd3)
+			return
+		} else if cmd2 == "tags" {
+			commands.GetTags(rc, resources, cmd3)
+			return
+		} else if cmd2 == "ipam" {
+			commands.GetIpam(rc, resources, cmd3)
+			return
+		} else if cmd2 == "duplicates" {
+			commands.GetDuplicates(rc, resources)
+			return
+		}
+	}
+	if cmd1 == "diff" {
+		commands.Diff(rc, resources, cmd2, cmd3)
+		return
+	}
+	if cmd1 == "k8s" {
+		if cmd2 == "doctor" {
+			commands.K8sDoctor(rc, resources, cmd3)
+			return
+		} else if cmd2 == "posture" {
+			commands.K8sPosture(rc, resources, cmd3, cmd4)
+			return
+		} else if cmd2 == "capacity" {
+			commands.K8sCapacity(rc, resources, cmd3, cmd4)
+			return
+		}
+	}
+	if cmd1 == "changes" {
+		if cmd2 == "ignore" {
+			commands.ChangeIgnore(rc, resources, cmd3, cmd4)
+			return
+		}
+	}
+	if cmd1 == "search" {
+		commands.Search(rc, resources, strings.TrimSpace(strings.Join([]string{cmd2, cmd3, cmd4}, " ")))
+		return
+	}
+	if cmd1 == "ipam" {
+		if cmd2 == "reserve" {
+			commands.IpamReserve(rc, resources, cmd3, cmd4)
+			return
+		} else if cmd2 == "annotate" {
+			commands.IpamAnnotate(rc, resources, cmd3, cmd4)
+			return
+		} else if cmd2 == "release" {
+			commands.IpamRelease(rc, resources, cmd3)
+			return
+		}
+	}
+	if cmd1 == "tag" {
+		if cmd2 == "set" {
+			commands.SetTags(rc, resources, cmd3, cmd4)
+			return
+		} else if cmd2 == "remove" {
+			commands.RemoveTags(rc, resources, cmd3, cmd4)
+			return
+		}
+	}
+	if cmd1 == "schema" {
+		if cmd2 == "plan" {
+			commands.SchemaPlan(rc, resources, cmd3)
+			return
 		}
 	}
 	if cmd1 == "add" {
@@ -134,6 +256,37 @@ func main() {
 		} else if cmd2 == "cluster" {
 			commands.AddCluster(cmd3, cmd4, rc, resources)
 			return
+		} else if cmd2 == "power" {
+			commands.AddPowerDevice(cmd3, rc, resources)
+			return
+		} else if cmd2 == "env" {
+			commands.AddEnvDevice(cmd3, rc, resources)
+			return
+		} else if cmd2 == "sensor" {
+			commands.AddSensorBinding(cmd3, rc, resources)
+			return
+		} else if cmd2 == "policy" {
+			commands.AddPolicy(cmd3, rc, resources)
+			return
+		} else if cmd2 == "query" {
+			commands.AddQuery(cmd3, rc, resources)
+			return
+		}
+	} else if cmd1 == "export" {
+		if cmd2 == "assets" {
+			commands.ExportAssets(rc, resources, cmd3, cmd4, cmd5)
+			return
+		} else if cmd2 == "changes" {
+			commands.ExportChanges(rc, resources, cmd3, cmd4)
+			return
+		} else if cmd2 == "backup" {
+			commands.ExportBackup(rc, resources, cmd3, cmd4)
+			return
+		}
+	} else if cmd1 == "import" {
+		if cmd2 == "backup" {
+			commands.ImportBackup(rc, resources, cmd3)
+			return
 		}
 	} else if cmd1 == "top" {
 		commands.Top(rc, resources)
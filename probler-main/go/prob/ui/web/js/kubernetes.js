@@ -310,7 +310,7 @@ function processK8sApiResponse(apiResponse) {
                         name: node.name,
                         status: 1, // Assume Ready status since API doesn't provide status
                         roles: node.roles || '<none>',
-                        age: node.age,
+                        age: node.age && typeof node.age === 'object' ? (node.age.age || '') : node.age,
                         version: node.version,
                         internal_ip: node.internalIp,
                         external_ip: node.externalIp,
@@ -340,7 +340,7 @@ function processK8sApiResponse(apiResponse) {
                         ready: ready,
                         status: 1, // Assume Running status since API doesn't provide status
                         restarts: restarts,
-                        age: pod.age,
+                        age: pod.age && typeof pod.age === 'object' ? (pod.age.age || '') : pod.age,
                         ip: pod.ip,
                         node: pod.node,
                         nominated_node: pod.nominatedNode,
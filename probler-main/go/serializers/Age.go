@@ -0,0 +1,144 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serializers
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/saichler/l8types/go/ifs"
+	types2 "github.com/saichler/probler/go/types"
+)
+
+var ageUnits = map[byte]time.Duration{
+	'y': time.Hour * 24 * 365,
+	'd': time.Hour * 24,
+	'h': time.Hour,
+	'm': time.Minute,
+	's': time.Second,
+}
+
+type Age struct{}
+
+func (this *Age) Mode() ifs.SerializerMode {
+	return ifs.STRING
+}
+func (this *Age) Marshal(any interface{}, r ifs.IResources) ([]byte, error) {
+	return nil, nil
+}
+func (this *Age) Unmarshal(data []byte, r ifs.IResources) (interface{}, error) {
+	str := strings.TrimSpace(string(data))
+	age := &types2.K8SAge{Age: str}
+	d, ok := ParseAge(str)
+	if ok {
+		age.Created = createdTimes.stable(time.Now().Add(-d).Unix(), int64(granularity(str)/time.Second))
+	}
+	return age, nil
+}
+
+// CREATED_TTL is how long a computed creation time is kept after it was last parsed.
+const CREATED_TTL = time.Hour
+
+// created keeps the creation times computed from the ages, by their granularity and bucket. kubectl
+// rounds the ages, so the creation time computed from the age of the same object moves by up to the
+// granularity from poll to poll.
+type created struct {
+	mtx    *sync.Mutex
+	times  map[int64]map[int64]int64
+	used   map[int64]int64
+	pruned int64
+}
+
+var createdTimes = &created{mtx: &sync.Mutex{}, times: make(map[int64]map[int64]int64),
+	used: make(map[int64]int64)}
+
+// stable returns a creation time computed before within the granularity of the given one, so the
+// polls of an object keep the first creation time computed for it, or the given one.
+func (this *created) stable(value, granularity int64) int64 {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	now := time.Now().Unix()
+	this.prune(now)
+	buckets, ok := this.times[granularity]
+	if !ok {
+		buckets = make(map[int64]int64)
+		this.times[granularity] = buckets
+	}
+	bucket := value / granularity
+	for _, b := range []int64{bucket, bucket - 1, bucket + 1} {
+		known, ok := buckets[b]
+		if ok && known-value <= granularity && value-known <= granularity {
+			this.used[known] = now
+			return known
+		}
+	}
+	buckets[bucket] = value
+	this.used[value] = now
+	return value
+}
+
+func (this *created) prune(now int64) {
+	ttl := int64(CREATED_TTL / time.Second)
+	if now-this.pruned < ttl {
+		return
+	}
+	this.pruned = now
+	for _, buckets := range this.times {
+		for bucket, value := range buckets {
+			if now-this.used[value] > ttl {
+				delete(buckets, bucket)
+				delete(this.used, value)
+			}
+		}
+	}
+}
+
+// granularity returns the unit of the last component of a kubectl age, e.g. an hour for 5d3h.
+func granularity(str string) time.Duration {
+	unit, ok := ageUnits[str[len(str)-1]]
+	if !ok {
+		return time.Second
+	}
+	return unit
+}
+
+// ParseAge parses a kubectl age such as 45s, 3h20m, 5d3h or 2y30d, kubectl ages like
+// <unknown> or <invalid> are not parsed.
+func ParseAge(str string) (time.Duration, bool) {
+	if str == "" {
+		return 0, false
+	}
+	result := time.Duration(0)
+	start := 0
+	for i := 0; i < len(str); i++ {
+		unit, ok := ageUnits[str[i]]
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(str[start:i])
+		if err != nil {
+			return 0, false
+		}
+		result += time.Duration(n) * unit
+		start = i + 1
+	}
+	if start != len(str) {
+		return 0, false
+	}
+	return result, true
+}
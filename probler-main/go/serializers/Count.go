@@ -0,0 +1,40 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serializers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/saichler/l8types/go/ifs"
+	types2 "github.com/saichler/probler/go/types"
+)
+
+type Count struct{}
+
+func (this *Count) Mode() ifs.SerializerMode {
+	return ifs.STRING
+}
+func (this *Count) Marshal(any interface{}, r ifs.IResources) ([]byte, error) {
+	return nil, nil
+}
+func (this *Count) Unmarshal(data []byte, r ifs.IResources) (interface{}, error) {
+	c, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, nil
+	}
+	return &types2.K8SCount{Count: int32(c)}, nil
+}
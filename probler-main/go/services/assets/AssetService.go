@@ -0,0 +1,155 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assets
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/groups"
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	ServiceName   = "Assets"
+	ServiceArea   = byte(0)
+	SCAN_INTERVAL = time.Minute * 5
+)
+
+// AssetService keeps the hardware asset register up to date by periodically scanning
+// the network device cache, and serves the register filtered by device and/or state.
+// The register is stored in the orm database, so a restart does not see every serial
+// appear again.
+type AssetService struct {
+	vnic     ifs.IVNic
+	table    *persist.Table
+	register *Register
+	running  bool
+}
+
+func Activate(db *sql.DB, vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&AssetService{}, ServiceName, ServiceArea, false, nil)
+	sla.SetArgs(db)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ServiceName, ": ", err.Error())
+	}
+}
+
+func (this *AssetService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	table, err := persist.NewTable(sla.Args()[0].(*sql.DB), &types.Asset{})
+	if err != nil {
+		return err
+	}
+	elems, err := table.LoadAll()
+	if err != nil {
+		return err
+	}
+	stored := make([]*types.Asset, 0, len(elems))
+	for _, elem := range elems {
+		stored = append(stored, elem.(*types.Asset))
+	}
+	this.vnic = vnic
+	this.table = table
+	this.register = NewRegister()
+	this.register.Load(stored)
+	this.running = true
+	vnic.Resources().Registry().Register(&types.AssetQuery{})
+	vnic.Resources().Registry().Register(&types.Asset{})
+	vnic.Resources().Registry().Register(&types.AssetList{})
+	go this.scan()
+	return nil
+}
+
+func (this *AssetService) DeActivate() error {
+	this.running = false
+	return nil
+}
+
+func (this *AssetService) scan() {
+	for this.running {
+		devices, err := common.NetworkDevices(this.vnic)
+		if err != nil {
+			this.vnic.Resources().Logger().Error(ServiceName, " scan failed: ", err.Error())
+		} else if len(devices) > 0 {
+			//An empty cache, e.g. one still reloading, would mark every stored asset missing
+			this.save(this.register.Update(devices, time.Now().Unix()))
+		}
+		time.Sleep(SCAN_INTERVAL)
+	}
+}
+
+func (this *AssetService) save(changed []*types.Asset) {
+	for _, asset := range changed {
+		err := this.table.Save(asset.SerialNumber, asset)
+		if err != nil {
+			this.vnic.Resources().Logger().Error(ServiceName, " failed to save ", asset.SerialNumber, ": ", err.Error())
+		}
+	}
+}
+
+func (this *AssetService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Post is not supported by " + ServiceName)
+}
+
+func (this *AssetService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Put is not supported by " + ServiceName)
+}
+
+func (this *AssetService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ServiceName)
+}
+
+func (this *AssetService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Delete is not supported by " + ServiceName)
+}
+
+func (this *AssetService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, _ := pb.Element().(*types.AssetQuery)
+	list := this.register.List(query)
+	if query != nil && query.Group != "" {
+		members, err := groups.Members(query.Group, this.vnic)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		filtered := list.List[:0]
+		for _, asset := range list.List {
+			if members[asset.DeviceId] {
+				filtered = append(filtered, asset)
+			}
+		}
+		list.List = filtered
+	}
+	return object.New(nil, list)
+}
+
+func (this *AssetService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *AssetService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *AssetService) WebService() ifs.IWebService {
+	return web.New(ServiceName, ServiceArea, nil, nil, nil, nil, nil, nil, nil, nil,
+		&types.AssetQuery{}, &types.AssetList{})
+}
@@ -0,0 +1,56 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assets
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	"github.com/saichler/probler/go/types"
+)
+
+var csvHeader = []string{"Serial Number", "Device", "Component", "Kind", "Name", "Vendor", "Model",
+	"Asset Id", "FRU", "Manufacturing Date", "Firmware", "State", "First Seen", "Last Seen"}
+
+// ToCSV renders the asset list as CSV, one row per asset, without the history.
+func ToCSV(list *types.AssetList) ([]byte, error) {
+	buff := &bytes.Buffer{}
+	w := csv.NewWriter(buff)
+	err := w.Write(csvHeader)
+	if err != nil {
+		return nil, err
+	}
+	for _, asset := range list.List {
+		err = w.Write([]string{asset.SerialNumber, asset.DeviceId, asset.ComponentPath,
+			asset.Kind.String(), asset.Name, asset.Vendor, asset.Model, asset.AssetId,
+			strconv.FormatBool(asset.IsFru), asset.ManufacturingDate, asset.FirmwareVersion,
+			asset.State.String(), toTime(asset.FirstSeen), toTime(asset.LastSeen)})
+		if err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buff.Bytes(), w.Error()
+}
+
+func toTime(unix int64) string {
+	if unix == 0 {
+		return ""
+	}
+	return time.Unix(unix, 0).UTC().Format(time.RFC3339)
+}
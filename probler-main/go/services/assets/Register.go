@@ -0,0 +1,269 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assets
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+)
+
+const MAX_ASSET_HISTORY = 50
+
+// Register is the serial keyed asset register, it is updated from full scans of the
+// network device inventory and keeps the history of every serial it has ever seen. The
+// register is kept in memory, its owner stores the assets each update changes.
+type Register struct {
+	assets map[string]*types.Asset
+	mtx    *sync.RWMutex
+}
+
+func NewRegister() *Register {
+	return &Register{assets: make(map[string]*types.Asset), mtx: &sync.RWMutex{}}
+}
+
+// Load adds stored assets to the register, e.g. the assets of a previous run.
+func (this *Register) Load(assets []*types.Asset) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	for _, asset := range assets {
+		if asset != nil && asset.SerialNumber != "" {
+			this.assets[asset.SerialNumber] = asset
+		}
+	}
+}
+
+// Update reconciles the register with the assets found in the given devices and returns a copy of
+// the assets it changed. Serials that were not found in the scan are marked as missing, and when a
+// missing serial was replaced by a new serial in the same component, the replacement is recorded.
+func (this *Register) Update(devices []*types.NetworkDevice, now int64) []*types.Asset {
+	observed := Flatten(devices)
+
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+
+	changed := make([]*types.Asset, 0)
+	appeared := make(map[string]*types.Asset)
+	for serial, asset := range observed {
+		existing, ok := this.assets[serial]
+		if !ok {
+			asset.State = types.AssetState_ASSET_STATE_PRESENT
+			asset.FirstSeen = now
+			asset.LastSeen = now
+			addEvent(asset, &types.AssetEvent{EventType: types.AssetEventType_ASSET_EVENT_APPEARED,
+				Timestamp: now, ToDevice: asset.DeviceId, ComponentPath: asset.ComponentPath})
+			this.assets[serial] = asset
+			appeared[location(asset)] = asset
+			changed = append(changed, proto.Clone(asset).(*types.Asset))
+			continue
+		}
+		if existing.DeviceId != asset.DeviceId {
+			addEvent(existing, &types.AssetEvent{EventType: types.AssetEventType_ASSET_EVENT_MOVED,
+				Timestamp: now, FromDevice: existing.DeviceId, ToDevice: asset.DeviceId, ComponentPath: asset.ComponentPath})
+		} else if existing.State == types.AssetState_ASSET_STATE_MISSING {
+			addEvent(existing, &types.AssetEvent{EventType: types.AssetEventType_ASSET_EVENT_RETURNED,
+				Timestamp: now, ToDevice: asset.DeviceId, ComponentPath: asset.ComponentPath})
+		}
+		history := existing.History
+		firstSeen := existing.FirstSeen
+		proto.Reset(existing)
+		proto.Merge(existing, asset)
+		existing.History = history
+		existing.FirstSeen = firstSeen
+		existing.LastSeen = now
+		existing.State = types.AssetState_ASSET_STATE_PRESENT
+		changed = append(changed, proto.Clone(existing).(*types.Asset))
+	}
+
+	for serial, asset := range this.assets {
+		_, ok := observed[serial]
+		if ok || asset.State == types.AssetState_ASSET_STATE_MISSING {
+			continue
+		}
+		asset.State = types.AssetState_ASSET_STATE_MISSING
+		event := &types.AssetEvent{EventType: types.AssetEventType_ASSET_EVENT_DISAPPEARED,
+			Timestamp: now, FromDevice: asset.DeviceId, ComponentPath: asset.ComponentPath}
+		//A new serial showing up in the exact same spot is most likely an RMA replacement
+		replacement, ok := appeared[location(asset)]
+		if ok {
+			event.EventType = types.AssetEventType_ASSET_EVENT_REPLACED
+			event.ReplacedBy = replacement.SerialNumber
+		}
+		addEvent(asset, event)
+		changed = append(changed, proto.Clone(asset).(*types.Asset))
+	}
+	return changed
+}
+
+// List returns a copy of the assets matching the query, sorted by serial number.
+func (this *Register) List(query *types.AssetQuery) *types.AssetList {
+	this.mtx.RLock()
+	defer this.mtx.RUnlock()
+	list := &types.AssetList{List: make([]*types.Asset, 0, len(this.assets))}
+	for _, asset := range this.assets {
+		if query != nil {
+			if query.DeviceId != "" && query.DeviceId != asset.DeviceId {
+				continue
+			}
+			if query.State != types.AssetState_ASSET_STATE_UNKNOWN && query.State != asset.State {
+				continue
+			}
+		}
+		list.List = append(list.List, proto.Clone(asset).(*types.Asset))
+	}
+	sort.Slice(list.List, func(i, j int) bool {
+		return list.List[i].SerialNumber < list.List[j].SerialNumber
+	})
+	return list
+}
+
+func addEvent(asset *types.Asset, event *types.AssetEvent) {
+	asset.History = append(asset.History, event)
+	if len(asset.History) > MAX_ASSET_HISTORY {
+		asset.History = asset.History[len(asset.History)-MAX_ASSET_HISTORY:]
+	}
+}
+
+func location(asset *types.Asset) string {
+	return asset.DeviceId + "/" + asset.ComponentPath
+}
+
+// Flatten collects every serialized component of the given devices into a serial keyed map.
+// Devices are walked in Id order so when the same serial is reported twice, the result is stable.
+func Flatten(devices []*types.NetworkDevice) map[string]*types.Asset {
+	sorted := make([]*types.NetworkDevice, 0, len(devices))
+	for _, device := range devices {
+		if device != nil {
+			sorted = append(sorted, device)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Id < sorted[j].Id
+	})
+
+	result := make(map[string]*types.Asset)
+	for _, device := range sorted {
+		flattenDevice(device, result)
+	}
+	return result
+}
+
+func flattenDevice(device *types.NetworkDevice, result map[string]*types.Asset) {
+	vendor := ""
+	info := device.Equipmentinfo
+	if info != nil {
+		vendor = info.Vendor
+		add(result, &types.Asset{SerialNumber: info.SerialNumber,
+			DeviceId:          device.Id,
+			ComponentPath:     "equipmentinfo",
+			Kind:              types.AssetKind_ASSET_KIND_DEVICE,
+			Name:              info.SysName,
+			Vendor:            vendor,
+			Model:             info.Model,
+			AssetId:           info.AssetId,
+			IsFru:             info.IsFru,
+			ManufacturingDate: info.ManufacturingDate,
+			FirmwareVersion:   info.FirmwareVersion})
+	}
+
+	keys := make([]string, 0, len(device.Physicals))
+	for key := range device.Physicals {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		physical := device.Physicals[key]
+		if physical == nil {
+			continue
+		}
+		path := "physicals/" + key
+		for i, chassis := range physical.Chassis {
+			flattenChassis(device.Id, vendor, path+"/chassis/"+strconv.Itoa(i), chassis, result)
+		}
+		for i, ps := range physical.PowerSupplies {
+			addPowerSupply(device.Id, vendor, path+"/power_supplies/"+strconv.Itoa(i), ps, result)
+		}
+	}
+}
+
+func flattenChassis(deviceId, vendor, path string, chassis *types.Chassis, result map[string]*types.Asset) {
+	if chassis == nil {
+		return
+	}
+	add(result, &types.Asset{SerialNumber: chassis.SerialNumber,
+		DeviceId:      deviceId,
+		ComponentPath: path,
+		Kind:          types.AssetKind_ASSET_KIND_CHASSIS,
+		Name:          chassis.Description,
+		Vendor:        vendor,
+		Model:         chassis.Model})
+	for i, module := range chassis.Modules {
+		addModule(deviceId, vendor, path+"/modules/"+strconv.Itoa(i), module, result)
+	}
+	for _, slot := range chassis.Slots {
+		if slot != nil {
+			addModule(deviceId, vendor, path+"/slots/"+slot.Id+"/module", slot.Module, result)
+		}
+	}
+	for i, ps := range chassis.PowerSupplies {
+		addPowerSupply(deviceId, vendor, path+"/power_supplies/"+strconv.Itoa(i), ps, result)
+	}
+}
+
+func addModule(deviceId, vendor, path string, module *types.Module, result map[string]*types.Asset) {
+	if module == nil {
+		return
+	}
+	add(result, &types.Asset{SerialNumber: module.SerialNumber,
+		DeviceId:      deviceId,
+		ComponentPath: path,
+		Kind:          types.AssetKind_ASSET_KIND_MODULE,
+		Name:          module.Name,
+		Vendor:        vendor,
+		Model:         module.Model,
+		IsFru:         true})
+}
+
+func addPowerSupply(deviceId, vendor, path string, ps *types.PowerSupply, result map[string]*types.Asset) {
+	if ps == nil {
+		return
+	}
+	add(result, &types.Asset{SerialNumber: ps.SerialNumber,
+		DeviceId:      deviceId,
+		ComponentPath: path,
+		Kind:          types.AssetKind_ASSET_KIND_POWER_SUPPLY,
+		Name:          ps.Name,
+		Vendor:        vendor,
+		Model:         ps.Model,
+		IsFru:         true})
+}
+
+func add(result map[string]*types.Asset, asset *types.Asset) {
+	asset.SerialNumber = strings.TrimSpace(asset.SerialNumber)
+	if asset.SerialNumber == "" {
+		return
+	}
+	_, exist := result[asset.SerialNumber]
+	if exist {
+		return
+	}
+	result[asset.SerialNumber] = asset
+}
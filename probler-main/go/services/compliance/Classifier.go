@@ -0,0 +1,162 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compliance
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/saichler/probler/go/types"
+)
+
+// Classify matches every device against its most specific version policy and classifies it as
+// compliant, upgrade-needed or unsupported. Devices not matching the query are left out.
+func Classify(devices []*types.NetworkDevice, policies []*types.VersionPolicy, query *types.ComplianceQuery) *types.ComplianceReport {
+	report := &types.ComplianceReport{Devices: make([]*types.DeviceCompliance, 0, len(devices))}
+	for _, device := range devices {
+		if device == nil || !matchQuery(device, query) {
+			continue
+		}
+		dc := classifyDevice(device, policies)
+		switch dc.Status {
+		case types.ComplianceStatus_COMPLIANCE_STATUS_COMPLIANT:
+			report.CompliantCount++
+		case types.ComplianceStatus_COMPLIANCE_STATUS_UPGRADE_NEEDED:
+			report.UpgradeNeededCount++
+		default:
+			report.UnsupportedCount++
+		}
+		report.Devices = append(report.Devices, dc)
+	}
+	sort.Slice(report.Devices, func(i, j int) bool {
+		return report.Devices[i].DeviceId < report.Devices[j].DeviceId
+	})
+	return report
+}
+
+func classifyDevice(device *types.NetworkDevice, policies []*types.VersionPolicy) *types.DeviceCompliance {
+	dc := &types.DeviceCompliance{DeviceId: device.Id}
+	info := device.Equipmentinfo
+	if info != nil {
+		dc.SysName = info.SysName
+		dc.Vendor = info.Vendor
+		dc.Family = info.Family
+		dc.Model = info.Model
+		dc.Location = info.Location
+		dc.RunningVersion = RunningVersion(info)
+	}
+
+	policy := MatchPolicy(dc.Vendor, dc.Family, dc.Model, policies)
+	if policy == nil {
+		dc.Status = types.ComplianceStatus_COMPLIANCE_STATUS_UNSUPPORTED
+		dc.Reason = "No version policy covers this platform"
+		return dc
+	}
+	dc.Policy = policy.Name
+	dc.TargetVersion = policy.TargetVersion
+
+	if dc.RunningVersion == "" {
+		dc.Status = types.ComplianceStatus_COMPLIANCE_STATUS_UNSUPPORTED
+		dc.Reason = "The running version was not collected"
+		return dc
+	}
+	if sameVersion(dc.RunningVersion, policy.TargetVersion) {
+		dc.Status = types.ComplianceStatus_COMPLIANCE_STATUS_COMPLIANT
+		dc.Reason = "Running the target version"
+		return dc
+	}
+	for _, approved := range policy.ApprovedVersions {
+		if sameVersion(dc.RunningVersion, approved) {
+			dc.Status = types.ComplianceStatus_COMPLIANCE_STATUS_COMPLIANT
+			dc.Reason = "Running an approved version"
+			return dc
+		}
+	}
+	if policy.TargetVersion != "" {
+		dc.Status = types.ComplianceStatus_COMPLIANCE_STATUS_UPGRADE_NEEDED
+		dc.Reason = "Running version is not approved, upgrade to " + policy.TargetVersion
+		return dc
+	}
+	dc.Status = types.ComplianceStatus_COMPLIANCE_STATUS_UNSUPPORTED
+	dc.Reason = "Running version is not approved and the policy has no target version"
+	return dc
+}
+
+// RunningVersion returns the software version a device reports, falling back to its firmware version.
+func RunningVersion(info *types.EquipmentInfo) string {
+	version := strings.TrimSpace(info.Version)
+	if version != "" {
+		return version
+	}
+	return strings.TrimSpace(info.FirmwareVersion)
+}
+
+// MatchPolicy returns the most specific policy for the platform, a model match outweighs a family
+// match which outweighs a vendor match. Ties are broken by the policy name.
+func MatchPolicy(vendor, family, model string, policies []*types.VersionPolicy) *types.VersionPolicy {
+	var best *types.VersionPolicy
+	bestScore := -1
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+		score, ok := policyScore(policy, vendor, family, model)
+		if !ok {
+			continue
+		}
+		if score > bestScore || (score == bestScore && policy.Name < best.Name) {
+			best = policy
+			bestScore = score
+		}
+	}
+	return best
+}
+
+func policyScore(policy *types.VersionPolicy, vendor, family, model string) (int, bool) {
+	score := 0
+	fields := [][2]string{{policy.Vendor, vendor}, {policy.Family, family}, {policy.Model, model}}
+	for i, field := range fields {
+		if field[0] == "" {
+			continue
+		}
+		if !strings.EqualFold(strings.TrimSpace(field[0]), strings.TrimSpace(field[1])) {
+			return 0, false
+		}
+		score += 1 << i
+	}
+	return score, true
+}
+
+func sameVersion(a, b string) bool {
+	return b != "" && strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
+
+func matchQuery(device *types.NetworkDevice, query *types.ComplianceQuery) bool {
+	if query == nil {
+		return true
+	}
+	info := device.Equipmentinfo
+	if info == nil {
+		return query.Location == "" && query.Vendor == ""
+	}
+	if query.Location != "" && !strings.Contains(strings.ToLower(info.Location), strings.ToLower(query.Location)) {
+		return false
+	}
+	if query.Vendor != "" && !strings.EqualFold(info.Vendor, query.Vendor) {
+		return false
+	}
+	return true
+}
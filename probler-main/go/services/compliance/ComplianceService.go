@@ -0,0 +1,137 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compliance
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/groups"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	ServiceName = "Comply"
+	ServiceArea = byte(0)
+)
+
+// ComplianceService classifies the devices in the network device cache against the
+// version policies held by the PolicyService.
+type ComplianceService struct {
+	vnic ifs.IVNic
+}
+
+// Activate activates both the version policy service and the compliance report service.
+func Activate(db *sql.DB, vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&PolicyService{}, PolicyServiceName, PolicyServiceArea, false, nil)
+	sla.SetArgs(db)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", PolicyServiceName, ": ", err.Error())
+	}
+	sla = ifs.NewServiceLevelAgreement(&ComplianceService{}, ServiceName, ServiceArea, false, nil)
+	_, err = vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ServiceName, ": ", err.Error())
+	}
+}
+
+func (this *ComplianceService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	this.vnic = vnic
+	vnic.Resources().Registry().Register(&types.ComplianceQuery{})
+	vnic.Resources().Registry().Register(&types.ComplianceReport{})
+	return nil
+}
+
+func (this *ComplianceService) DeActivate() error {
+	this.vnic = nil
+	return nil
+}
+
+func (this *ComplianceService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Post is not supported by " + ServiceName)
+}
+
+func (this *ComplianceService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Put is not supported by " + ServiceName)
+}
+
+func (this *ComplianceService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ServiceName)
+}
+
+func (this *ComplianceService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Delete is not supported by " + ServiceName)
+}
+
+func (this *ComplianceService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, _ := pb.Element().(*types.ComplianceQuery)
+	policies, err := this.policies()
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	devices, err := common.NetworkDevices(this.vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	if query != nil && query.Group != "" {
+		members, err := groups.Members(query.Group, this.vnic)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		filtered := make([]*types.NetworkDevice, 0, len(members))
+		for _, device := range devices {
+			if device != nil && members[device.Id] {
+				filtered = append(filtered, device)
+			}
+		}
+		devices = filtered
+	}
+	return object.New(nil, Classify(devices, policies, query))
+}
+
+func (this *ComplianceService) policies() ([]*types.VersionPolicy, error) {
+	resp := this.vnic.Request("", PolicyServiceName, PolicyServiceArea, ifs.GET, &types.VersionPolicy{},
+		common.INVENTORY_REQUEST_TIMEOUT)
+	if resp == nil {
+		return nil, errors.New("No response from " + PolicyServiceName)
+	}
+	if resp.Error() != nil {
+		return nil, resp.Error()
+	}
+	list, ok := resp.Element().(*types.VersionPolicyList)
+	if !ok {
+		return nil, errors.New("Unexpected response type from " + PolicyServiceName)
+	}
+	return list.List, nil
+}
+
+func (this *ComplianceService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *ComplianceService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *ComplianceService) WebService() ifs.IWebService {
+	return web.New(ServiceName, ServiceArea, nil, nil, nil, nil, nil, nil, nil, nil,
+		&types.ComplianceQuery{}, &types.ComplianceReport{})
+}
@@ -0,0 +1,126 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compliance
+
+import (
+	"database/sql"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	PolicyServiceName = "VerPolicy"
+	PolicyServiceArea = byte(0)
+)
+
+// PolicyService holds the software/firmware version policies, keyed by their name, in the orm
+// database so they survive a restart.
+type PolicyService struct {
+	table *persist.Table
+}
+
+func (this *PolicyService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	table, err := persist.NewTable(sla.Args()[0].(*sql.DB), &types.VersionPolicy{})
+	if err != nil {
+		return err
+	}
+	this.table = table
+	vnic.Resources().Registry().Register(&types.VersionPolicy{})
+	vnic.Resources().Registry().Register(&types.VersionPolicyList{})
+	return nil
+}
+
+func (this *PolicyService) DeActivate() error {
+	this.table = nil
+	return nil
+}
+
+func (this *PolicyService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	policy, ok := pb.Element().(*types.VersionPolicy)
+	if !ok || policy.Name == "" {
+		return object.NewError("Expected a version policy with a name")
+	}
+	err := this.table.Save(policy.Name, policy)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, policy)
+}
+
+func (this *PolicyService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.Post(pb, vnic)
+}
+
+func (this *PolicyService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + PolicyServiceName)
+}
+
+func (this *PolicyService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	policy, ok := pb.Element().(*types.VersionPolicy)
+	if !ok || policy.Name == "" {
+		return object.NewError("Expected a version policy with a name")
+	}
+	err := this.table.Delete(policy.Name)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, policy)
+}
+
+// Get returns the policy with the given name, or all the policies when the name is empty.
+func (this *PolicyService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	list := &types.VersionPolicyList{List: make([]*types.VersionPolicy, 0)}
+	policy, ok := pb.Element().(*types.VersionPolicy)
+	if ok && policy.Name != "" {
+		elem, err := this.table.Load(policy.Name)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		if elem != nil {
+			list.List = append(list.List, elem.(*types.VersionPolicy))
+		}
+		return object.New(nil, list)
+	}
+	elems, err := this.table.LoadAll()
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	for _, elem := range elems {
+		list.List = append(list.List, elem.(*types.VersionPolicy))
+	}
+	return object.New(nil, list)
+}
+
+func (this *PolicyService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *PolicyService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *PolicyService) WebService() ifs.IWebService {
+	return web.New(PolicyServiceName, PolicyServiceArea,
+		&types.VersionPolicy{}, &types.VersionPolicy{},
+		&types.VersionPolicy{}, &types.VersionPolicy{},
+		nil, nil,
+		&types.VersionPolicy{}, &types.VersionPolicy{},
+		&types.VersionPolicy{}, &types.VersionPolicyList{})
+}
@@ -0,0 +1,246 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conditions
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The optional path element fanning out over a list or a map, e.g. logicals.*.interfaces
+const ANY = "*"
+
+// Condition is a query condition compiled against an element type, its field path checked.
+type Condition struct {
+	steps    []*step
+	operator types.ConditionOperator
+	value    string
+	number   float64
+	regex    *regexp.Regexp
+}
+
+// step is a field on a condition's path, with the key of a map field when the path selects one.
+type step struct {
+	field protoreflect.FieldDescriptor
+	key   string
+}
+
+// leaf is a scalar value found on a field path, set is false for a proto3 zero value.
+type leaf struct {
+	text string
+	set  bool
+}
+
+// Compile checks the condition's field path against the element type and prepares its value.
+func Compile(descriptor protoreflect.MessageDescriptor, c *types.QueryCondition) (*Condition, error) {
+	if c == nil {
+		return nil, errors.New("Empty condition")
+	}
+	result := &Condition{operator: c.Operator, value: strings.ToLower(c.Value)}
+	collection := false
+	keyed := false
+	for _, name := range strings.Split(c.Field, ".") {
+		if name == ANY {
+			if !collection {
+				return nil, errors.New(ANY + " in " + c.Field + " does not follow a list or a map")
+			}
+			collection, keyed = false, false
+			continue
+		}
+		//A map key, unless it is a field of the map's values, e.g. tags.env
+		if keyed && (descriptor == nil || fieldByName(descriptor, name) == nil) {
+			result.steps[len(result.steps)-1].key = name
+			collection, keyed = false, false
+			continue
+		}
+		if descriptor == nil {
+			return nil, errors.New(c.Field + " continues past a value")
+		}
+		field := fieldByName(descriptor, name)
+		if field == nil {
+			return nil, errors.New("No field " + name + " in " + string(descriptor.Name()))
+		}
+		result.steps = append(result.steps, &step{field: field})
+		descriptor = elemDescriptor(field)
+		collection = isCollection(field)
+		keyed = field.IsMap() && field.MapKey().Kind() == protoreflect.StringKind
+	}
+	if descriptor != nil {
+		return nil, errors.New(c.Field + " is not a value")
+	}
+	switch c.Operator {
+	case types.ConditionOperator_CONDITION_EQUALS, types.ConditionOperator_CONDITION_NOT_EQUALS,
+		types.ConditionOperator_CONDITION_CONTAINS, types.ConditionOperator_CONDITION_PREFIX,
+		types.ConditionOperator_CONDITION_EXISTS:
+	case types.ConditionOperator_CONDITION_MATCHES:
+		regex, err := regexp.Compile("(?i)" + c.Value)
+		if err != nil {
+			return nil, err
+		}
+		result.regex = regex
+	case types.ConditionOperator_CONDITION_GREATER, types.ConditionOperator_CONDITION_LESS:
+		number, err := strconv.ParseFloat(c.Value, 64)
+		if err != nil {
+			return nil, errors.New(c.Field + " is compared to " + c.Value + ", which is not a number")
+		}
+		result.number = number
+	default:
+		return nil, errors.New("No operator for " + c.Field)
+	}
+	return result, nil
+}
+
+// fieldByName finds the field by its proto name, or by its name in the inventory queries, e.g.
+// DeviceStatus or devicestatus for device_status.
+func fieldByName(descriptor protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	field := descriptor.Fields().ByName(protoreflect.Name(name))
+	if field != nil {
+		return field
+	}
+	name = strings.ReplaceAll(name, "_", "")
+	fields := descriptor.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if strings.EqualFold(strings.ReplaceAll(string(fields.Get(i).Name()), "_", ""), name) {
+			return fields.Get(i)
+		}
+	}
+	return nil
+}
+
+// Match reports whether a value on the condition's field path of the element satisfies it.
+func (this *Condition) Match(elem proto.Message) bool {
+	return this.match(values(elem.ProtoReflect(), this.steps, nil))
+}
+
+func (this *Condition) match(values []leaf) bool {
+	if this.operator == types.ConditionOperator_CONDITION_NOT_EQUALS {
+		for _, v := range values {
+			if strings.ToLower(v.text) == this.value {
+				return false
+			}
+		}
+		return true
+	}
+	for _, v := range values {
+		text := strings.ToLower(v.text)
+		switch this.operator {
+		case types.ConditionOperator_CONDITION_EQUALS:
+			if text == this.value {
+				return true
+			}
+		case types.ConditionOperator_CONDITION_CONTAINS:
+			if strings.Contains(text, this.value) {
+				return true
+			}
+		case types.ConditionOperator_CONDITION_PREFIX:
+			if strings.HasPrefix(text, this.value) {
+				return true
+			}
+		case types.ConditionOperator_CONDITION_MATCHES:
+			if this.regex.MatchString(v.text) {
+				return true
+			}
+		case types.ConditionOperator_CONDITION_EXISTS:
+			if v.set {
+				return true
+			}
+		case types.ConditionOperator_CONDITION_GREATER, types.ConditionOperator_CONDITION_LESS:
+			number, err := strconv.ParseFloat(v.text, 64)
+			if err != nil {
+				continue
+			}
+			if this.operator == types.ConditionOperator_CONDITION_GREATER && number > this.number ||
+				this.operator == types.ConditionOperator_CONDITION_LESS && number < this.number {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// values collects the scalar values on the field path, fanning out over the lists and the maps.
+func values(msg protoreflect.Message, steps []*step, result []leaf) []leaf {
+	field := steps[0].field
+	value := msg.Get(field)
+	var elems []protoreflect.Value
+	switch {
+	case field.IsList():
+		list := value.List()
+		for i := 0; i < list.Len(); i++ {
+			elems = append(elems, list.Get(i))
+		}
+	case field.IsMap() && steps[0].key != "":
+		v := value.Map().Get(protoreflect.ValueOfString(steps[0].key).MapKey())
+		if v.IsValid() {
+			elems = append(elems, v)
+		}
+		field = field.MapValue()
+	case field.IsMap():
+		value.Map().Range(func(key protoreflect.MapKey, v protoreflect.Value) bool {
+			elems = append(elems, v)
+			return true
+		})
+		field = field.MapValue()
+	default:
+		if field.Message() != nil && !msg.Has(field) {
+			return result
+		}
+		if field.Message() == nil {
+			return append(result, leaf{text: text(field, value), set: msg.Has(field)})
+		}
+		elems = append(elems, value)
+	}
+	for _, elem := range elems {
+		if field.Message() == nil {
+			result = append(result, leaf{text: text(field, elem), set: true})
+		} else if len(steps) > 1 {
+			result = values(elem.Message(), steps[1:], result)
+		}
+	}
+	return result
+}
+
+func text(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	if field.Kind() == protoreflect.EnumKind {
+		enum := field.Enum().Values().ByNumber(value.Enum())
+		if enum != nil {
+			return string(enum.Name())
+		}
+		return strconv.Itoa(int(value.Enum()))
+	}
+	if field.Kind() == protoreflect.BytesKind {
+		return string(value.Bytes())
+	}
+	return value.String()
+}
+
+// elemDescriptor is the message of the field's elements, or nil when they are values.
+func elemDescriptor(field protoreflect.FieldDescriptor) protoreflect.MessageDescriptor {
+	if field.IsMap() {
+		return field.MapValue().Message()
+	}
+	return field.Message()
+}
+
+func isCollection(field protoreflect.FieldDescriptor) bool {
+	return field.IsList() || field.IsMap()
+}
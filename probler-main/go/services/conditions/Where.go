@@ -0,0 +1,133 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conditions
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var selectExpr = regexp.MustCompile(`(?is)^\s*select\s+.+?\s+from\s+(\w+)(?:\s+where\s+(.+?))?\s*$`)
+var andExpr = regexp.MustCompile(`(?i)\s+and\s+`)
+var orExpr = regexp.MustCompile(`(?i)(^|\s)or(\s|$)`)
+var termExpr = regexp.MustCompile(`^([\w.*-]+)\s*(!=|=|>|<)\s*(?:'([^']*)'|([^\s'=][^\s']*|))$`)
+var groupExpr = regexp.MustCompile(`(?i)(?:^|\s)group\s*=\s*(?:'([^']*)'|([^\s']*))`)
+
+var operators = map[string]types.ConditionOperator{
+	"=":  types.ConditionOperator_CONDITION_EQUALS,
+	"!=": types.ConditionOperator_CONDITION_NOT_EQUALS,
+	">":  types.ConditionOperator_CONDITION_GREATER,
+	"<":  types.ConditionOperator_CONDITION_LESS,
+}
+
+// The prefix of a user defined tag in a where clause, e.g. tag.env=prod, matching the tags field.
+const TAG_PREFIX = "tag."
+
+// The term of a where clause selecting the members of a saved query, e.g. group=cisco-routers. It is
+// not a field of the element, the members are resolved by the groups service, see Groups.
+const GROUP_FIELD = "group"
+
+// Where compiles the where clause of an inventory query, e.g. "select * from NetworkDevice where
+// equipmentinfo.vendor=Cisco and Id!=10.1.1.1", against the element type. The terms are and-ed, a
+// value with a * matches as a wildcard and the group terms are left to Groups. A clause that cannot
+// be applied, e.g. an or, a sort-by, a >= or a field the type does not have, is an error rather than
+// dropped.
+func Where(descriptor protoreflect.MessageDescriptor, text string) ([]*Condition, error) {
+	match := selectExpr.FindStringSubmatch(text)
+	if match == nil {
+		return nil, errors.New("Unsupported query '" + text + "', expected select * from <type> [where <field>=<value> [and ...]]")
+	}
+	if !strings.EqualFold(match[1], string(descriptor.Name())) {
+		return nil, errors.New("Unexpected type " + match[1] + ", expected " + string(descriptor.Name()))
+	}
+	if match[2] == "" {
+		return nil, nil
+	}
+	if strings.ContainsAny(match[2], "()") || orExpr.MatchString(match[2]) {
+		return nil, errors.New("Unsupported where clause '" + match[2] + "', only and-ed terms are supported")
+	}
+	result := make([]*Condition, 0)
+	for _, term := range andExpr.Split(strings.TrimSpace(match[2]), -1) {
+		parts := termExpr.FindStringSubmatch(strings.TrimSpace(term))
+		if parts == nil {
+			return nil, errors.New("Unsupported term '" + term + "', expected <field><operator><value>")
+		}
+		value := parts[3] + parts[4]
+		if strings.EqualFold(parts[1], GROUP_FIELD) {
+			if parts[2] != "=" || value == "" || strings.Contains(value, ANY) {
+				return nil, errors.New("A group is only supported by = and a saved query name in '" + term + "'")
+			}
+			continue
+		}
+		field := fieldPath(descriptor, parts[1])
+		operator, ok := operators[parts[2]]
+		if !ok {
+			return nil, errors.New("Unsupported operator " + parts[2] + " in '" + term + "'")
+		}
+		if strings.Contains(value, ANY) {
+			if operator != types.ConditionOperator_CONDITION_EQUALS {
+				return nil, errors.New("A wildcard is only supported by = in '" + term + "'")
+			}
+			operator = types.ConditionOperator_CONDITION_MATCHES
+			value = "^" + strings.ReplaceAll(regexp.QuoteMeta(value), `\*`, ".*") + "$"
+		}
+		condition, err := Compile(descriptor, &types.QueryCondition{Field: field, Operator: operator, Value: value})
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, condition)
+	}
+	return result, nil
+}
+
+// Groups returns the names of the saved queries the where clause selects the members of, e.g.
+// cisco-routers of "select * from NetworkDevice where group=cisco-routers". An element must be a
+// member of all of them.
+func Groups(text string) []string {
+	result := make([]string, 0)
+	for _, match := range groupExpr.FindAllStringSubmatch(text, -1) {
+		result = append(result, match[1]+match[2])
+	}
+	return result
+}
+
+// MatchAll reports whether the element satisfies all the conditions.
+func MatchAll(elem proto.Message, conditions []*Condition) bool {
+	for _, c := range conditions {
+		if !c.Match(elem) {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldPath drops the type name the path may start with, e.g. networkdevice.id, and maps a tag to
+// the tags field, e.g. tag.env to tags.env.
+func fieldPath(descriptor protoreflect.MessageDescriptor, path string) string {
+	prefix := string(descriptor.Name()) + "."
+	if len(path) > len(prefix) && strings.EqualFold(path[:len(prefix)], prefix) {
+		path = path[len(prefix):]
+	}
+	if len(path) > len(TAG_PREFIX) && strings.EqualFold(path[:len(TAG_PREFIX)], TAG_PREFIX) {
+		return "tags." + path[len(TAG_PREFIX):]
+	}
+	return path
+}
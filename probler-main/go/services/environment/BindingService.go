@@ -0,0 +1,167 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package environment
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	BindingServiceName = "EnvBind"
+	BindingServiceArea = byte(2)
+)
+
+// BindingService holds the rack/room binding of the environmental sensors, keyed by
+// <env device id>/<sensor id>, in the orm database so they survive a restart.
+type BindingService struct {
+	table *persist.Table
+}
+
+// Activate activates the heat map service, next to the environmental device cache.
+func Activate(vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&HeatMapService{}, ServiceName, ServiceArea, false, nil)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ServiceName, ": ", err.Error())
+	}
+}
+
+// ActivateBindings activates the sensor binding service over the orm database.
+func ActivateBindings(db *sql.DB, vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&BindingService{}, BindingServiceName, BindingServiceArea, false, nil)
+	sla.SetArgs(db)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", BindingServiceName, ": ", err.Error())
+	}
+}
+
+// Bindings fetches all the sensor bindings from the binding service.
+func Bindings(vnic ifs.IVNic) ([]*types.EnvSensorBinding, error) {
+	resp := vnic.Request("", BindingServiceName, BindingServiceArea, ifs.GET, &types.EnvSensorBinding{}, common.INVENTORY_REQUEST_TIMEOUT)
+	if resp == nil {
+		return nil, errors.New("No response from " + BindingServiceName)
+	}
+	if resp.Error() != nil {
+		return nil, resp.Error()
+	}
+	list, ok := resp.Element().(*types.EnvSensorBindingList)
+	if !ok {
+		return nil, errors.New("Unexpected response type from " + BindingServiceName)
+	}
+	return list.List, nil
+}
+
+func (this *BindingService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	table, err := persist.NewTable(sla.Args()[0].(*sql.DB), &types.EnvSensorBinding{})
+	if err != nil {
+		return err
+	}
+	this.table = table
+	vnic.Resources().Registry().Register(&types.EnvSensorBinding{})
+	vnic.Resources().Registry().Register(&types.EnvSensorBindingList{})
+	return nil
+}
+
+func (this *BindingService) DeActivate() error {
+	this.table = nil
+	return nil
+}
+
+func (this *BindingService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	binding, ok := pb.Element().(*types.EnvSensorBinding)
+	if !ok || binding.DeviceId == "" || binding.SensorId == "" {
+		return object.NewError("Expected a sensor binding with a device Id and a sensor Id")
+	}
+	if binding.RackId == "" && binding.RoomId == "" {
+		return object.NewError("Sensor binding " + BindingId(binding.DeviceId, binding.SensorId) + " has no rack or room")
+	}
+	if binding.Threshold != nil && binding.Threshold.High != 0 && binding.Threshold.High < binding.Threshold.Low {
+		return object.NewError("Sensor binding " + BindingId(binding.DeviceId, binding.SensorId) + " has a high threshold below its low threshold")
+	}
+	binding.Id = BindingId(binding.DeviceId, binding.SensorId)
+	err := this.table.Save(binding.Id, binding)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, binding)
+}
+
+func (this *BindingService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.Post(pb, vnic)
+}
+
+func (this *BindingService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + BindingServiceName)
+}
+
+func (this *BindingService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	binding, ok := pb.Element().(*types.EnvSensorBinding)
+	if !ok || binding.DeviceId == "" || binding.SensorId == "" {
+		return object.NewError("Expected a sensor binding with a device Id and a sensor Id")
+	}
+	err := this.table.Delete(BindingId(binding.DeviceId, binding.SensorId))
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, binding)
+}
+
+// Get returns the bindings of the given env device, or all the bindings when the device Id is empty.
+func (this *BindingService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	deviceId := ""
+	binding, ok := pb.Element().(*types.EnvSensorBinding)
+	if ok {
+		deviceId = binding.DeviceId
+	}
+	elems, err := this.table.LoadAll()
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	list := &types.EnvSensorBindingList{List: make([]*types.EnvSensorBinding, 0, len(elems))}
+	for _, elem := range elems {
+		b := elem.(*types.EnvSensorBinding)
+		if deviceId == "" || deviceId == b.DeviceId {
+			list.List = append(list.List, b)
+		}
+	}
+	return object.New(nil, list)
+}
+
+func (this *BindingService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *BindingService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *BindingService) WebService() ifs.IWebService {
+	return web.New(BindingServiceName, BindingServiceArea,
+		&types.EnvSensorBinding{}, &types.EnvSensorBinding{},
+		&types.EnvSensorBinding{}, &types.EnvSensorBinding{},
+		nil, nil,
+		&types.EnvSensorBinding{}, &types.EnvSensorBinding{},
+		&types.EnvSensorBinding{}, &types.EnvSensorBindingList{})
+}
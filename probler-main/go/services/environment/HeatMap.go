@@ -0,0 +1,209 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package environment
+
+import (
+	"sort"
+
+	"github.com/saichler/probler/go/types"
+)
+
+// Locations resolves the rack and room a sensor is bound to, the room of a rack
+// is the room of its row.
+type Locations struct {
+	racks     map[string]*types.Rack
+	rackRoom  map[string]string
+	roomNames map[string]string
+}
+
+func NewLocations(racks []*types.Rack, rows []*types.RackRow, sites []*types.Site) *Locations {
+	locations := &Locations{racks: make(map[string]*types.Rack), rackRoom: make(map[string]string),
+		roomNames: make(map[string]string)}
+	rowRoom := make(map[string]string)
+	for _, row := range rows {
+		rowRoom[row.Id] = row.RoomId
+	}
+	for _, rack := range racks {
+		locations.racks[rack.Id] = rack
+		locations.rackRoom[rack.Id] = rowRoom[rack.RowId]
+	}
+	for _, site := range sites {
+		for _, building := range site.Buildings {
+			for _, room := range building.Rooms {
+				locations.roomNames[room.Id] = room.Name
+			}
+		}
+	}
+	return locations
+}
+
+// Build creates the heat map of the environmental sensors, one entry per rack or room that has
+// bound sensors, along with every reading that breaches its threshold.
+func Build(devices []*types.EnvDevice, bindings []*types.EnvSensorBinding, locations *Locations, query *types.HeatMapQuery) *types.HeatMap {
+	if query == nil {
+		query = &types.HeatMapQuery{}
+	}
+	if locations == nil {
+		locations = NewLocations(nil, nil, nil)
+	}
+	bound := make(map[string]*types.EnvSensorBinding)
+	for _, binding := range bindings {
+		bound[BindingId(binding.DeviceId, binding.SensorId)] = binding
+	}
+
+	heatMap := &types.HeatMap{Locations: make([]*types.HeatMapLocation, 0), Breaches: make([]*types.EnvReading, 0)}
+	byLocation := make(map[string]*types.HeatMapLocation)
+	for _, device := range devices {
+		for sensorId, sensor := range device.Sensors {
+			if sensor == nil || !Operational(sensor) {
+				continue
+			}
+			kind := Kind(sensor)
+			if kind == types.EnvSensorKind_ENV_SENSOR_KIND_UNSPECIFIED {
+				continue
+			}
+			binding, ok := bound[BindingId(device.Id, sensorId)]
+			if !ok || (binding.RackId == "" && binding.RoomId == "") {
+				heatMap.UnboundSensors++
+				continue
+			}
+			if query.Kind != types.EnvSensorKind_ENV_SENSOR_KIND_UNSPECIFIED && query.Kind != kind {
+				continue
+			}
+			location := locations.location(binding)
+			if !matches(location, query) {
+				continue
+			}
+			key := location.LocationType.String() + "/" + location.LocationId
+			existing, ok := byLocation[key]
+			if !ok {
+				byLocation[key] = location
+				heatMap.Locations = append(heatMap.Locations, location)
+			} else {
+				location = existing
+			}
+
+			threshold := binding.Threshold
+			if threshold == nil {
+				threshold = DefaultThreshold(kind)
+			}
+			reading := &types.EnvReading{DeviceId: device.Id,
+				SensorId:   sensorId,
+				Name:       sensor.Name,
+				Kind:       kind,
+				Value:      Value(sensor),
+				Units:      sensor.Units,
+				LocationId: location.LocationId}
+			if threshold != nil {
+				reading.Low = threshold.Low
+				reading.High = threshold.High
+			}
+			reading.Breach = Breach(kind, reading.Value, threshold)
+			location.Readings = append(location.Readings, reading)
+			if reading.Breach != types.EnvBreach_ENV_BREACH_NONE {
+				location.BreachCount++
+				heatMap.Breaches = append(heatMap.Breaches, reading)
+			}
+		}
+	}
+
+	for _, location := range heatMap.Locations {
+		aggregate(location)
+	}
+	sort.Slice(heatMap.Locations, func(i, j int) bool {
+		if heatMap.Locations[i].LocationType != heatMap.Locations[j].LocationType {
+			return heatMap.Locations[i].LocationType < heatMap.Locations[j].LocationType
+		}
+		return heatMap.Locations[i].LocationId < heatMap.Locations[j].LocationId
+	})
+	sort.Slice(heatMap.Breaches, func(i, j int) bool {
+		return lessReading(heatMap.Breaches[i], heatMap.Breaches[j])
+	})
+	return heatMap
+}
+
+// BindingId is the key of the binding of a sensor.
+func BindingId(deviceId, sensorId string) string {
+	return deviceId + "/" + sensorId
+}
+
+func (this *Locations) location(binding *types.EnvSensorBinding) *types.HeatMapLocation {
+	if binding.RackId != "" {
+		location := &types.HeatMapLocation{LocationType: types.EnvLocationType_ENV_LOCATION_TYPE_RACK,
+			LocationId: binding.RackId, Name: binding.RackId, RoomId: this.rackRoom[binding.RackId]}
+		rack, ok := this.racks[binding.RackId]
+		if ok && rack.Name != "" {
+			location.Name = rack.Name
+		}
+		return location
+	}
+	location := &types.HeatMapLocation{LocationType: types.EnvLocationType_ENV_LOCATION_TYPE_ROOM,
+		LocationId: binding.RoomId, Name: binding.RoomId, RoomId: binding.RoomId}
+	name := this.roomNames[binding.RoomId]
+	if name != "" {
+		location.Name = name
+	}
+	return location
+}
+
+func matches(location *types.HeatMapLocation, query *types.HeatMapQuery) bool {
+	if query.RoomId != "" && query.RoomId != location.RoomId {
+		return false
+	}
+	if query.RackId != "" {
+		return location.LocationType == types.EnvLocationType_ENV_LOCATION_TYPE_RACK &&
+			location.LocationId == query.RackId
+	}
+	return true
+}
+
+func aggregate(location *types.HeatMapLocation) {
+	sort.Slice(location.Readings, func(i, j int) bool {
+		return lessReading(location.Readings[i], location.Readings[j])
+	})
+	temperatures := 0
+	humidity := 0.0
+	humidities := 0
+	for _, reading := range location.Readings {
+		switch reading.Kind {
+		case types.EnvSensorKind_ENV_SENSOR_KIND_TEMPERATURE:
+			if temperatures == 0 || reading.Value > location.MaxTemperature {
+				location.MaxTemperature = reading.Value
+			}
+			temperatures++
+		case types.EnvSensorKind_ENV_SENSOR_KIND_HUMIDITY:
+			humidity += reading.Value
+			humidities++
+		case types.EnvSensorKind_ENV_SENSOR_KIND_LEAK:
+			if reading.Breach == types.EnvBreach_ENV_BREACH_LEAK {
+				location.Leak = true
+			}
+		}
+	}
+	if humidities > 0 {
+		location.AvgHumidity = humidity / float64(humidities)
+	}
+}
+
+func lessReading(a, b *types.EnvReading) bool {
+	if a.LocationId != b.LocationId {
+		return a.LocationId < b.LocationId
+	}
+	if a.DeviceId != b.DeviceId {
+		return a.DeviceId < b.DeviceId
+	}
+	return a.SensorId < b.SensorId
+}
@@ -0,0 +1,103 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package environment
+
+import (
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/racks"
+	"github.com/saichler/probler/go/services/sites"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	ServiceName = "HeatMap"
+	ServiceArea = byte(2)
+)
+
+// HeatMapService serves the per rack/room readings of the environmental sensors in the
+// env device cache, along with their threshold breaches.
+type HeatMapService struct {
+	vnic ifs.IVNic
+}
+
+func (this *HeatMapService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	this.vnic = vnic
+	vnic.Resources().Registry().Register(&types.HeatMapQuery{})
+	vnic.Resources().Registry().Register(&types.HeatMap{})
+	return nil
+}
+
+func (this *HeatMapService) DeActivate() error {
+	this.vnic = nil
+	return nil
+}
+
+func (this *HeatMapService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Post is not supported by " + ServiceName)
+}
+
+func (this *HeatMapService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Put is not supported by " + ServiceName)
+}
+
+func (this *HeatMapService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ServiceName)
+}
+
+func (this *HeatMapService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Delete is not supported by " + ServiceName)
+}
+
+func (this *HeatMapService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, _ := pb.Element().(*types.HeatMapQuery)
+	devices, err := common.EnvDevices(this.vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	bindings, err := Bindings(this.vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	rackList, err := racks.Racks("", this.vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	rows, err := racks.Rows("", this.vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	siteList, err := sites.Sites(this.vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, Build(devices, bindings, NewLocations(rackList, rows, siteList), query))
+}
+
+func (this *HeatMapService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *HeatMapService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *HeatMapService) WebService() ifs.IWebService {
+	return web.New(ServiceName, ServiceArea, nil, nil, nil, nil, nil, nil, nil, nil,
+		&types.HeatMapQuery{}, &types.HeatMap{})
+}
@@ -0,0 +1,116 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package environment
+
+import (
+	"math"
+
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	//ASHRAE recommended envelope for the air inlet of IT equipment
+	DEFAULT_TEMPERATURE_LOW  = 18.0
+	DEFAULT_TEMPERATURE_HIGH = 27.0
+	DEFAULT_HUMIDITY_LOW     = 20.0
+	DEFAULT_HUMIDITY_HIGH    = 80.0
+	//entPhySensorValue of a truthvalue sensor, true(1) means a leak was detected
+	LEAK_DETECTED = 1
+)
+
+// exponents of the entPhySensorScale values, note that exa(14) comes before peta(15) in the MIB
+var exponents = map[types.EnvSensorScale]int{
+	types.EnvSensorScale_ENV_SENSOR_SCALE_YOCTO: -24,
+	types.EnvSensorScale_ENV_SENSOR_SCALE_ZEPTO: -21,
+	types.EnvSensorScale_ENV_SENSOR_SCALE_ATTO:  -18,
+	types.EnvSensorScale_ENV_SENSOR_SCALE_FEMTO: -15,
+	types.EnvSensorScale_ENV_SENSOR_SCALE_PICO:  -12,
+	types.EnvSensorScale_ENV_SENSOR_SCALE_NANO:  -9,
+	types.EnvSensorScale_ENV_SENSOR_SCALE_MICRO: -6,
+	types.EnvSensorScale_ENV_SENSOR_SCALE_MILLI: -3,
+	types.EnvSensorScale_ENV_SENSOR_SCALE_KILO:  3,
+	types.EnvSensorScale_ENV_SENSOR_SCALE_MEGA:  6,
+	types.EnvSensorScale_ENV_SENSOR_SCALE_GIGA:  9,
+	types.EnvSensorScale_ENV_SENSOR_SCALE_TERA:  12,
+	types.EnvSensorScale_ENV_SENSOR_SCALE_EXA:   18,
+	types.EnvSensorScale_ENV_SENSOR_SCALE_PETA:  15,
+	types.EnvSensorScale_ENV_SENSOR_SCALE_ZETTA: 21,
+	types.EnvSensorScale_ENV_SENSOR_SCALE_YOTTA: 24,
+}
+
+// Kind maps the ENTITY-SENSOR-MIB type of a sensor to the environmental kind it measures,
+// sensors of any other type (volts, amps, fans rpm etc.) are not environmental sensors.
+func Kind(sensor *types.EnvSensor) types.EnvSensorKind {
+	switch sensor.SensorType {
+	case types.EnvSensorType_ENV_SENSOR_TYPE_CELSIUS:
+		return types.EnvSensorKind_ENV_SENSOR_KIND_TEMPERATURE
+	case types.EnvSensorType_ENV_SENSOR_TYPE_PERCENT_RH:
+		return types.EnvSensorKind_ENV_SENSOR_KIND_HUMIDITY
+	case types.EnvSensorType_ENV_SENSOR_TYPE_CMM:
+		return types.EnvSensorKind_ENV_SENSOR_KIND_AIRFLOW
+	case types.EnvSensorType_ENV_SENSOR_TYPE_TRUTH_VALUE:
+		return types.EnvSensorKind_ENV_SENSOR_KIND_LEAK
+	}
+	return types.EnvSensorKind_ENV_SENSOR_KIND_UNSPECIFIED
+}
+
+// Value returns the reading of the sensor in units, applying its scale and precision to the raw value.
+func Value(sensor *types.EnvSensor) float64 {
+	value := float64(sensor.Value) * math.Pow10(exponents[sensor.Scale])
+	if sensor.Precision > 0 {
+		value = value / math.Pow10(int(sensor.Precision))
+	}
+	return value
+}
+
+// Operational returns false for sensors the device reported as unavailable or not operational,
+// their value is meaningless.
+func Operational(sensor *types.EnvSensor) bool {
+	return sensor.Status != types.EnvSensorStatus_ENV_SENSOR_STATUS_UNAVAILABLE &&
+		sensor.Status != types.EnvSensorStatus_ENV_SENSOR_STATUS_NONOPERATIONAL
+}
+
+// DefaultThreshold returns the thresholds of the kind, nil when the kind has no default
+// thresholds and should be bound with explicit ones.
+func DefaultThreshold(kind types.EnvSensorKind) *types.EnvThreshold {
+	switch kind {
+	case types.EnvSensorKind_ENV_SENSOR_KIND_TEMPERATURE:
+		return &types.EnvThreshold{Low: DEFAULT_TEMPERATURE_LOW, High: DEFAULT_TEMPERATURE_HIGH}
+	case types.EnvSensorKind_ENV_SENSOR_KIND_HUMIDITY:
+		return &types.EnvThreshold{Low: DEFAULT_HUMIDITY_LOW, High: DEFAULT_HUMIDITY_HIGH}
+	}
+	return nil
+}
+
+// Breach checks a reading against its threshold, a zero high threshold means there is no upper bound.
+func Breach(kind types.EnvSensorKind, value float64, threshold *types.EnvThreshold) types.EnvBreach {
+	if kind == types.EnvSensorKind_ENV_SENSOR_KIND_LEAK {
+		if value == LEAK_DETECTED {
+			return types.EnvBreach_ENV_BREACH_LEAK
+		}
+		return types.EnvBreach_ENV_BREACH_NONE
+	}
+	if threshold == nil {
+		return types.EnvBreach_ENV_BREACH_NONE
+	}
+	if value < threshold.Low {
+		return types.EnvBreach_ENV_BREACH_LOW
+	}
+	if threshold.High != 0 && value > threshold.High {
+		return types.EnvBreach_ENV_BREACH_HIGH
+	}
+	return types.EnvBreach_ENV_BREACH_NONE
+}
@@ -0,0 +1,172 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package groups
+
+import (
+	"errors"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/sites"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	ServiceName = "Groups"
+	ServiceArea = byte(0)
+)
+
+// GroupService resolves a saved query, or an unsaved one, to its current members in the
+// inventory caches. The members are never stored, a group is as dynamic as its query. A group
+// filters the inventory queries, as a group=<name> term, the asset and compliance reports and the
+// tag updates.
+type GroupService struct {
+	vnic ifs.IVNic
+}
+
+// Members returns the ids of the current members of the saved query, for the services and the
+// commands filtering by a group.
+func Members(name string, vnic ifs.IVNic) (map[string]bool, error) {
+	resp := vnic.Request("", ServiceName, ServiceArea, ifs.GET, &types.GroupQuery{Name: name, IdsOnly: true},
+		common.INVENTORY_REQUEST_TIMEOUT)
+	if resp == nil {
+		return nil, errors.New("No response from " + ServiceName)
+	}
+	if resp.Error() != nil {
+		return nil, resp.Error()
+	}
+	members, ok := resp.Element().(*types.GroupMembers)
+	if !ok {
+		return nil, errors.New("Unexpected response type from " + ServiceName)
+	}
+	return toSet(members.Ids), nil
+}
+
+func (this *GroupService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	this.vnic = vnic
+	vnic.Resources().Registry().Register(&types.GroupQuery{})
+	vnic.Resources().Registry().Register(&types.GroupMembers{})
+	return nil
+}
+
+func (this *GroupService) DeActivate() error {
+	this.vnic = nil
+	return nil
+}
+
+func (this *GroupService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Post is not supported by " + ServiceName)
+}
+
+func (this *GroupService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Put is not supported by " + ServiceName)
+}
+
+func (this *GroupService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ServiceName)
+}
+
+func (this *GroupService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Delete is not supported by " + ServiceName)
+}
+
+func (this *GroupService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	groupQuery, ok := pb.Element().(*types.GroupQuery)
+	if !ok {
+		return object.NewError("Expected a group query")
+	}
+	query := groupQuery.Query
+	if query == nil {
+		var err error
+		query, err = this.savedQuery(groupQuery.Name)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+	}
+	members, err := this.members(query)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	if groupQuery.IdsOnly {
+		members.Devices = nil
+		members.Clusters = nil
+	}
+	return object.New(nil, members)
+}
+
+func (this *GroupService) members(query *types.SavedQuery) (*types.GroupMembers, error) {
+	var devices []*types.NetworkDevice
+	var clusters []*types.K8SCluster
+	var assignments map[string]*sites.Assignment
+	var err error
+	switch query.Target {
+	case types.GroupTarget_GROUP_TARGET_NETWORK_DEVICE:
+		devices, err = common.NetworkDevices(this.vnic)
+		if err != nil {
+			return nil, err
+		}
+		if query.SiteId != "" {
+			list, err := sites.Sites(this.vnic)
+			if err != nil {
+				return nil, err
+			}
+			assignments = sites.Assign(devices, list)
+		}
+	case types.GroupTarget_GROUP_TARGET_K8S_CLUSTER:
+		clusters, err = common.K8sClusters(this.vnic)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return Evaluate(query, devices, clusters, assignments)
+}
+
+func (this *GroupService) savedQuery(name string) (*types.SavedQuery, error) {
+	if name == "" {
+		return nil, errors.New("Expected the name of a saved query")
+	}
+	resp := this.vnic.Request("", QueryServiceName, QueryServiceArea, ifs.GET, &types.SavedQuery{Name: name},
+		common.INVENTORY_REQUEST_TIMEOUT)
+	if resp == nil {
+		return nil, errors.New("No response from " + QueryServiceName)
+	}
+	if resp.Error() != nil {
+		return nil, resp.Error()
+	}
+	list, ok := resp.Element().(*types.SavedQueryList)
+	if !ok {
+		return nil, errors.New("Unexpected response type from " + QueryServiceName)
+	}
+	if len(list.List) == 0 {
+		return nil, errors.New("No saved query named " + name)
+	}
+	return list.List[0], nil
+}
+
+func (this *GroupService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *GroupService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *GroupService) WebService() ifs.IWebService {
+	return web.New(ServiceName, ServiceArea, nil, nil, nil, nil, nil, nil, nil, nil,
+		&types.GroupQuery{}, &types.GroupMembers{})
+}
@@ -0,0 +1,127 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package groups
+
+import (
+	"errors"
+
+	"github.com/saichler/probler/go/services/conditions"
+	"github.com/saichler/probler/go/services/sites"
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// Query is a compiled saved query, its field paths checked against the target type.
+type Query struct {
+	query      *types.SavedQuery
+	conditions []*conditions.Condition
+	include    map[string]bool
+	exclude    map[string]bool
+}
+
+// Sample returns an element of the target type, or nil for an unknown target.
+func Sample(target types.GroupTarget) proto.Message {
+	switch target {
+	case types.GroupTarget_GROUP_TARGET_NETWORK_DEVICE:
+		return &types.NetworkDevice{}
+	case types.GroupTarget_GROUP_TARGET_K8S_CLUSTER:
+		return &types.K8SCluster{}
+	}
+	return nil
+}
+
+// Compile checks the saved query and prepares its conditions for matching.
+func Compile(query *types.SavedQuery) (*Query, error) {
+	if query == nil || query.Name == "" {
+		return nil, errors.New("Expected a saved query with a name")
+	}
+	sample := Sample(query.Target)
+	if sample == nil {
+		return nil, errors.New("Saved query " + query.Name + " has no target")
+	}
+	if query.SiteId != "" && query.Target != types.GroupTarget_GROUP_TARGET_NETWORK_DEVICE {
+		return nil, errors.New("Saved query " + query.Name + " has a site, only network devices are assigned to sites")
+	}
+	result := &Query{query: query, include: toSet(query.IncludeIds), exclude: toSet(query.ExcludeIds)}
+	for _, c := range query.Conditions {
+		compiled, err := conditions.Compile(sample.ProtoReflect().Descriptor(), c)
+		if err != nil {
+			return nil, errors.New("Saved query " + query.Name + ": " + err.Error())
+		}
+		result.conditions = append(result.conditions, compiled)
+	}
+	return result, nil
+}
+
+// Match reports whether the element is a member, the include and exclude ids are not considered.
+func (this *Query) Match(elem proto.Message) bool {
+	return conditions.MatchAll(elem, this.conditions)
+}
+
+// Evaluate returns the members of the saved query among the devices, or the clusters, of its
+// target. The site of a network device is taken from the assignments, and the included ids are
+// members of an inventory element regardless of the conditions and the site.
+func Evaluate(query *types.SavedQuery, devices []*types.NetworkDevice, clusters []*types.K8SCluster,
+	assignments map[string]*sites.Assignment) (*types.GroupMembers, error) {
+	compiled, err := Compile(query)
+	if err != nil {
+		return nil, err
+	}
+	members := &types.GroupMembers{Name: query.Name, Target: query.Target, Ids: make([]string, 0)}
+	switch query.Target {
+	case types.GroupTarget_GROUP_TARGET_NETWORK_DEVICE:
+		for _, device := range devices {
+			if device == nil || !compiled.member(device.Id, device, assignments) {
+				continue
+			}
+			members.Ids = append(members.Ids, device.Id)
+			members.Devices = append(members.Devices, device)
+		}
+	case types.GroupTarget_GROUP_TARGET_K8S_CLUSTER:
+		for _, cluster := range clusters {
+			if cluster == nil || !compiled.member(cluster.Name, cluster, nil) {
+				continue
+			}
+			members.Ids = append(members.Ids, cluster.Name)
+			members.Clusters = append(members.Clusters, cluster)
+		}
+	}
+	return members, nil
+}
+
+func (this *Query) member(id string, elem proto.Message, assignments map[string]*sites.Assignment) bool {
+	if this.exclude[id] {
+		return false
+	}
+	if this.include[id] {
+		return true
+	}
+	if this.query.SiteId != "" {
+		assignment, ok := assignments[id]
+		if !ok || assignment.SiteId != this.query.SiteId {
+			return false
+		}
+	}
+	return this.Match(elem)
+}
+
+func toSet(ids []string) map[string]bool {
+	result := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		result[id] = true
+	}
+	return result
+}
@@ -0,0 +1,148 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package groups
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	QueryServiceName = "Queries"
+	QueryServiceArea = byte(0)
+)
+
+// QueryService holds the saved queries, keyed by their name, in the orm database so they
+// survive a restart.
+type QueryService struct {
+	table *persist.Table
+}
+
+// Activate activates both the saved query service and the group membership service.
+func Activate(db *sql.DB, vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&QueryService{}, QueryServiceName, QueryServiceArea, false, nil)
+	sla.SetArgs(db)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", QueryServiceName, ": ", err.Error())
+	}
+	sla = ifs.NewServiceLevelAgreement(&GroupService{}, ServiceName, ServiceArea, false, nil)
+	_, err = vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ServiceName, ": ", err.Error())
+	}
+	persist.SetGroupResolver(Members)
+}
+
+func (this *QueryService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	table, err := persist.NewTable(sla.Args()[0].(*sql.DB), &types.SavedQuery{})
+	if err != nil {
+		return err
+	}
+	this.table = table
+	vnic.Resources().Registry().RegisterEnums(types.GroupTarget_value)
+	vnic.Resources().Registry().RegisterEnums(types.ConditionOperator_value)
+	vnic.Resources().Registry().Register(&types.SavedQuery{})
+	vnic.Resources().Registry().Register(&types.SavedQueryList{})
+	return nil
+}
+
+func (this *QueryService) DeActivate() error {
+	this.table = nil
+	return nil
+}
+
+// Post adds, or replaces, the saved query after checking its conditions against the target type.
+func (this *QueryService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, _ := pb.Element().(*types.SavedQuery)
+	_, err := Compile(query)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	query.Updated = time.Now().Unix()
+	err = this.table.Save(query.Name, query)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, query)
+}
+
+func (this *QueryService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.Post(pb, vnic)
+}
+
+func (this *QueryService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + QueryServiceName)
+}
+
+func (this *QueryService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, ok := pb.Element().(*types.SavedQuery)
+	if !ok || query.Name == "" {
+		return object.NewError("Expected a saved query with a name")
+	}
+	err := this.table.Delete(query.Name)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, query)
+}
+
+// Get returns the saved query with the given name, or all the saved queries when the name is empty.
+func (this *QueryService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	list := &types.SavedQueryList{List: make([]*types.SavedQuery, 0)}
+	query, ok := pb.Element().(*types.SavedQuery)
+	if ok && query.Name != "" {
+		elem, err := this.table.Load(query.Name)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		if elem != nil {
+			list.List = append(list.List, elem.(*types.SavedQuery))
+		}
+		return object.New(nil, list)
+	}
+	elems, err := this.table.LoadAll()
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	for _, elem := range elems {
+		list.List = append(list.List, elem.(*types.SavedQuery))
+	}
+	return object.New(nil, list)
+}
+
+func (this *QueryService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *QueryService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *QueryService) WebService() ifs.IWebService {
+	return web.New(QueryServiceName, QueryServiceArea,
+		&types.SavedQuery{}, &types.SavedQuery{},
+		&types.SavedQuery{}, &types.SavedQuery{},
+		nil, nil,
+		&types.SavedQuery{}, &types.SavedQuery{},
+		&types.SavedQuery{}, &types.SavedQueryList{})
+}
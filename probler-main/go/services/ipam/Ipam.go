@@ -0,0 +1,298 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ipam
+
+import (
+	"errors"
+	"math"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/types"
+)
+
+// The prefix length of an address polled without one, when no known prefix contains it.
+const (
+	DEFAULT_IPV4_PREFIX = 24
+	DEFAULT_IPV6_PREFIX = 64
+)
+
+// binding is an address seen in the inventory, with its prefix when it was polled with one.
+type binding struct {
+	addr    netip.Addr
+	prefix  netip.Prefix
+	binding *types.IpamBinding
+}
+
+// ParseAddress parses an inventory address, e.g. 10.1.1.1 or 10.1.1.1/24, returning its prefix when it
+// has one. Loopback, link local and unspecified addresses are not managed and are not parsed.
+func ParseAddress(text string) (netip.Addr, netip.Prefix, bool) {
+	text = strings.TrimSpace(text)
+	var addr netip.Addr
+	var prefix netip.Prefix
+	if strings.Contains(text, "/") {
+		p, err := netip.ParsePrefix(text)
+		if err != nil {
+			return addr, prefix, false
+		}
+		addr = p.Addr()
+		prefix = p.Masked()
+	} else {
+		a, err := netip.ParseAddr(text)
+		if err != nil {
+			return addr, prefix, false
+		}
+		addr = a.Unmap()
+	}
+	if addr.IsUnspecified() || addr.IsLoopback() || addr.IsLinkLocalUnicast() {
+		return netip.Addr{}, netip.Prefix{}, false
+	}
+	return addr, prefix, true
+}
+
+// NormalizeMac returns the mac as lower case colon separated octets, or an empty string when it is
+// not a unicast mac, e.g. all zeros.
+func NormalizeMac(mac string) string {
+	mac = strings.ToLower(strings.NewReplacer(":", "", "-", "", ".", "").Replace(strings.TrimSpace(mac)))
+	if len(mac) != 12 || strings.Trim(mac, "0123456789abcdef") != "" ||
+		mac == "000000000000" || mac == "ffffffffffff" {
+		return ""
+	}
+	octets := make([]string, 6)
+	for i := range octets {
+		octets[i] = mac[i*2 : i*2+2]
+	}
+	return strings.Join(octets, ":")
+}
+
+// bindings collects the management addresses of the devices and the addresses of their interfaces.
+func bindings(devices []*types.NetworkDevice) []*binding {
+	result := make([]*binding, 0)
+	for _, device := range devices {
+		if device == nil {
+			continue
+		}
+		if device.Equipmentinfo != nil {
+			addr, prefix, ok := ParseAddress(device.Equipmentinfo.IpAddress)
+			if ok {
+				result = append(result, &binding{addr: addr, prefix: prefix,
+					binding: &types.IpamBinding{DeviceId: device.Id, Management: true}})
+			}
+		}
+		for _, iface := range common.Interfaces(device) {
+			addr, prefix, ok := ParseAddress(iface.IpAddress)
+			if !ok {
+				continue
+			}
+			name := iface.Name
+			if name == "" {
+				name = iface.Id
+			}
+			result = append(result, &binding{addr: addr, prefix: prefix,
+				binding: &types.IpamBinding{DeviceId: device.Id, Interface: name, MacAddress: NormalizeMac(iface.MacAddress)}})
+		}
+	}
+	return result
+}
+
+// Build maps the prefixes to their addresses and the addresses to the interfaces and devices they are
+// bound to, with their annotations. An address polled without a prefix belongs to the longest polled
+// prefix containing it, or to its default prefix. The counts of a prefix are of all its addresses,
+// also when the query shows only some of them.
+func Build(devices []*types.NetworkDevice, annotations []*types.IpamAnnotation, query *types.IpamQuery) (*types.IpamReport, error) {
+	var filter netip.Prefix
+	if query != nil && query.Prefix != "" {
+		var err error
+		filter, err = netip.ParsePrefix(query.Prefix)
+		if err != nil {
+			return nil, errors.New("Invalid prefix " + query.Prefix + ": " + err.Error())
+		}
+		filter = filter.Masked()
+	}
+	all := bindings(devices)
+	known := make([]netip.Prefix, 0)
+	for _, b := range all {
+		if b.prefix.IsValid() {
+			known = append(known, b.prefix)
+		}
+	}
+	//Longest prefixes first, so the first containing prefix is the longest match
+	sort.Slice(known, func(i, j int) bool {
+		return known[i].Bits() > known[j].Bits()
+	})
+
+	addresses := make(map[netip.Addr]*types.IpamAddress)
+	prefixes := make(map[netip.Prefix][]netip.Addr)
+	address := func(addr netip.Addr) *types.IpamAddress {
+		result, ok := addresses[addr]
+		if !ok {
+			prefix := prefixOf(addr, known)
+			result = &types.IpamAddress{Address: addr.String(), Prefix: prefix.String()}
+			addresses[addr] = result
+			prefixes[prefix] = append(prefixes[prefix], addr)
+		}
+		return result
+	}
+	for _, b := range all {
+		elem := address(b.addr)
+		elem.Bindings = append(elem.Bindings, b.binding)
+	}
+	for _, annotation := range annotations {
+		addr, _, ok := ParseAddress(annotation.Address)
+		if ok {
+			address(addr).Annotation = annotation
+		}
+	}
+
+	report := &types.IpamReport{Prefixes: make([]*types.IpamPrefix, 0), Duplicates: duplicates(all)}
+	for _, elem := range addresses {
+		elem.Duplicate = len(deviceIds(elem.Bindings)) > 1
+	}
+	if query != nil && query.DuplicatesOnly {
+		return report, nil
+	}
+	for _, prefix := range sortedPrefixes(prefixes) {
+		if filter.IsValid() && !filter.Overlaps(prefix) {
+			continue
+		}
+		elem := &types.IpamPrefix{Prefix: prefix.String(), Size: size(prefix)}
+		addrs := prefixes[prefix]
+		sort.Slice(addrs, func(i, j int) bool {
+			return addrs[i].Less(addrs[j])
+		})
+		for _, addr := range addrs {
+			seen := addresses[addr]
+			if len(seen.Bindings) > 0 {
+				elem.Used++
+			} else if seen.Annotation != nil && seen.Annotation.Reserved {
+				elem.Reserved++
+			}
+			if !filter.IsValid() || filter.Contains(addr) {
+				elem.Addresses = append(elem.Addresses, seen)
+			}
+		}
+		elem.Utilization = float64(elem.Used+elem.Reserved) * 100 / float64(elem.Size)
+		report.Prefixes = append(report.Prefixes, elem)
+	}
+	return report, nil
+}
+
+// InUse returns the bindings of the address in the inventory.
+func InUse(addr netip.Addr, devices []*types.NetworkDevice) []*types.IpamBinding {
+	result := make([]*types.IpamBinding, 0)
+	for _, b := range bindings(devices) {
+		if b.addr == addr {
+			result = append(result, b.binding)
+		}
+	}
+	return result
+}
+
+// duplicates returns the addresses and the macs bound on more than one device.
+func duplicates(all []*binding) []*types.IpamDuplicate {
+	byAddr := make(map[netip.Addr][]*types.IpamBinding)
+	byMac := make(map[string][]*types.IpamBinding)
+	for _, b := range all {
+		byAddr[b.addr] = append(byAddr[b.addr], b.binding)
+	}
+	seenMac := make(map[string]bool)
+	for _, b := range all {
+		mac := b.binding.MacAddress
+		key := b.binding.DeviceId + "/" + b.binding.Interface + "/" + mac
+		//An interface with several addresses holds its mac once
+		if mac != "" && !seenMac[key] {
+			seenMac[key] = true
+			byMac[mac] = append(byMac[mac], b.binding)
+		}
+	}
+	result := make([]*types.IpamDuplicate, 0)
+	addrs := make([]netip.Addr, 0, len(byAddr))
+	for addr := range byAddr {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].Less(addrs[j])
+	})
+	for _, addr := range addrs {
+		if len(deviceIds(byAddr[addr])) > 1 {
+			result = append(result, &types.IpamDuplicate{Kind: types.IpamDuplicateKind_IPAM_DUPLICATE_IP,
+				Value: addr.String(), Bindings: byAddr[addr]})
+		}
+	}
+	macs := make([]string, 0, len(byMac))
+	for mac := range byMac {
+		macs = append(macs, mac)
+	}
+	sort.Strings(macs)
+	for _, mac := range macs {
+		if len(deviceIds(byMac[mac])) > 1 {
+			result = append(result, &types.IpamDuplicate{Kind: types.IpamDuplicateKind_IPAM_DUPLICATE_MAC,
+				Value: mac, Bindings: byMac[mac]})
+		}
+	}
+	return result
+}
+
+func deviceIds(bindings []*types.IpamBinding) map[string]bool {
+	result := make(map[string]bool)
+	for _, b := range bindings {
+		result[b.DeviceId] = true
+	}
+	return result
+}
+
+func prefixOf(addr netip.Addr, known []netip.Prefix) netip.Prefix {
+	for _, prefix := range known {
+		if prefix.Contains(addr) {
+			return prefix
+		}
+	}
+	bits := DEFAULT_IPV6_PREFIX
+	if addr.Is4() {
+		bits = DEFAULT_IPV4_PREFIX
+	}
+	return netip.PrefixFrom(addr, bits).Masked()
+}
+
+// size is the number of usable addresses of the prefix, without the network and the broadcast
+// addresses of an ipv4 subnet.
+func size(prefix netip.Prefix) int64 {
+	hostBits := prefix.Addr().BitLen() - prefix.Bits()
+	if hostBits >= 63 {
+		return math.MaxInt64
+	}
+	count := int64(1) << hostBits
+	if prefix.Addr().Is4() && hostBits >= 2 {
+		count -= 2
+	}
+	return count
+}
+
+func sortedPrefixes(prefixes map[netip.Prefix][]netip.Addr) []netip.Prefix {
+	result := make([]netip.Prefix, 0, len(prefixes))
+	for prefix := range prefixes {
+		result = append(result, prefix)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Addr() != result[j].Addr() {
+			return result[i].Addr().Less(result[j].Addr())
+		}
+		return result[i].Bits() < result[j].Bits()
+	})
+	return result
+}
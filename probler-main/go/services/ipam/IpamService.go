@@ -0,0 +1,187 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ipam
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	ServiceName = "Ipam"
+	ServiceArea = byte(0)
+)
+
+// IpamService serves the address management view of the network device cache, and holds the
+// reservations and the annotations of the addresses in the orm database. An annotation is merged
+// with a Post, replaced with a Put and removed, with its reservation, with a Delete.
+type IpamService struct {
+	table *persist.Table
+	vnic  ifs.IVNic
+}
+
+func Activate(db *sql.DB, vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&IpamService{}, ServiceName, ServiceArea, false, nil)
+	sla.SetArgs(db)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ServiceName, ": ", err.Error())
+	}
+}
+
+func (this *IpamService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	table, err := persist.NewTable(sla.Args()[0].(*sql.DB), &types.IpamAnnotation{})
+	if err != nil {
+		return err
+	}
+	this.table = table
+	this.vnic = vnic
+	vnic.Resources().Registry().RegisterEnums(types.IpamDuplicateKind_value)
+	vnic.Resources().Registry().Register(&types.IpamAnnotation{})
+	vnic.Resources().Registry().Register(&types.IpamQuery{})
+	vnic.Resources().Registry().Register(&types.IpamReport{})
+	return nil
+}
+
+func (this *IpamService) DeActivate() error {
+	this.table = nil
+	return nil
+}
+
+func (this *IpamService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.annotate(pb, true)
+}
+
+func (this *IpamService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.annotate(pb, false)
+}
+
+func (this *IpamService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ServiceName)
+}
+
+// annotate saves the annotation of the address, refusing to reserve an address that is in use.
+func (this *IpamService) annotate(pb ifs.IElements, merge bool) ifs.IElements {
+	annotation, key, err := this.annotation(pb)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	if merge {
+		stored, err := this.table.Load(key)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		if stored != nil {
+			previous := stored.(*types.IpamAnnotation)
+			annotation.Reserved = annotation.Reserved || previous.Reserved
+			if annotation.Owner == "" {
+				annotation.Owner = previous.Owner
+			}
+			if annotation.Note == "" {
+				annotation.Note = previous.Note
+			}
+		}
+	}
+	if annotation.Reserved {
+		addr, _, _ := ParseAddress(key)
+		devices, err := common.NetworkDevices(this.vnic)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		used := InUse(addr, devices)
+		if len(used) > 0 {
+			return object.NewError(key + " is in use by " + used[0].DeviceId + ", it can not be reserved")
+		}
+	}
+	annotation.Updated = time.Now().Unix()
+	err = this.table.Save(key, annotation)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, annotation)
+}
+
+func (this *IpamService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	annotation, key, err := this.annotation(pb)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	err = this.table.Delete(key)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, annotation)
+}
+
+// annotation returns the annotation of the request and its key, the address in its canonical form.
+func (this *IpamService) annotation(pb ifs.IElements) (*types.IpamAnnotation, string, error) {
+	annotation, ok := pb.Element().(*types.IpamAnnotation)
+	if !ok {
+		return nil, "", errors.New("Expected an ipam annotation")
+	}
+	addr, prefix, ok := ParseAddress(annotation.Address)
+	if !ok || prefix.IsValid() {
+		return nil, "", errors.New("Invalid address '" + annotation.Address + "', expected a managed ip address")
+	}
+	annotation.Address = addr.String()
+	return annotation, annotation.Address, nil
+}
+
+func (this *IpamService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, _ := pb.Element().(*types.IpamQuery)
+	devices, err := common.NetworkDevices(this.vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	elems, err := this.table.LoadAll()
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	annotations := make([]*types.IpamAnnotation, len(elems))
+	for i, elem := range elems {
+		annotations[i] = elem.(*types.IpamAnnotation)
+	}
+	report, err := Build(devices, annotations, query)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, report)
+}
+
+func (this *IpamService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *IpamService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *IpamService) WebService() ifs.IWebService {
+	return web.New(ServiceName, ServiceArea,
+		&types.IpamAnnotation{}, &types.IpamAnnotation{},
+		&types.IpamAnnotation{}, &types.IpamAnnotation{},
+		nil, nil,
+		&types.IpamAnnotation{}, &types.IpamAnnotation{},
+		&types.IpamQuery{}, &types.IpamReport{})
+}
@@ -0,0 +1,180 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8scapacity
+
+import (
+	"math"
+	"sort"
+
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	DEFAULT_POD_CPU    = "100m"
+	DEFAULT_POD_MEMORY = "128Mi"
+)
+
+// Compute aggregates the requests and limits of the pods on every node of a cluster against the node's
+// allocatable resources, and how many more pods of podCPU millicores and podMemory bytes fit on it.
+// The cluster total is the sum of the schedulable nodes only, and its pods fit is the sum of the nodes,
+// as a pod has to fit on a single node. A node no limit applies to is unbounded, and so is the total.
+func Compute(cluster *types.K8SCluster, podCPU, podMemory int64) *types.K8SCapacityReport {
+	report := &types.K8SCapacityReport{Cluster: cluster.Name, Total: &types.K8SCapacity{Name: cluster.Name}}
+	objects := cluster.Objects
+	if objects == nil {
+		return report
+	}
+	nodes := make(map[string]*types.K8SCapacity)
+	for _, node := range objects.Nodes {
+		if node.Metadata == nil {
+			continue
+		}
+		capacity := allocatable(node)
+		nodes[capacity.Name] = capacity
+		report.Nodes = append(report.Nodes, capacity)
+	}
+	for _, pod := range objects.Pods {
+		if pod.Spec == nil || pod.Status.GetPhase() == "Succeeded" || pod.Status.GetPhase() == "Failed" {
+			continue
+		}
+		capacity, ok := nodes[pod.Spec.NodeName]
+		if !ok {
+			continue
+		}
+		cpu, memory, cpuLimit, memoryLimit := podResources(pod.Spec)
+		capacity.RequestedCpu += cpu
+		capacity.RequestedMemory += memory
+		capacity.LimitCpu += cpuLimit
+		capacity.LimitMemory += memoryLimit
+		capacity.PodCount++
+	}
+	total := report.Total
+	for _, capacity := range report.Nodes {
+		ratios(capacity)
+		if !capacity.Schedulable {
+			continue
+		}
+		capacity.PodsFit, capacity.PodsFitUnbounded = fit(capacity, podCPU, podMemory)
+		total.Schedulable = true
+		total.AllocatableCpu += capacity.AllocatableCpu
+		total.RequestedCpu += capacity.RequestedCpu
+		total.LimitCpu += capacity.LimitCpu
+		total.AllocatableMemory += capacity.AllocatableMemory
+		total.RequestedMemory += capacity.RequestedMemory
+		total.LimitMemory += capacity.LimitMemory
+		total.AllocatablePods += capacity.AllocatablePods
+		total.PodCount += capacity.PodCount
+		total.PodsFit = int32(min(int64(total.PodsFit)+int64(capacity.PodsFit), math.MaxInt32))
+		total.PodsFitUnbounded = total.PodsFitUnbounded || capacity.PodsFitUnbounded
+	}
+	ratios(total)
+	sort.Slice(report.Nodes, func(i, j int) bool {
+		return report.Nodes[i].Name < report.Nodes[j].Name
+	})
+	return report
+}
+
+// allocatable returns the capacity of a node with its allocatable resources, taken from the API maps
+// and falling back to the flat fields, or for the pods, which have none, to the node's capacity.
+func allocatable(node *types.Node) *types.K8SCapacity {
+	capacity := &types.K8SCapacity{Name: node.Metadata.Name}
+	status := node.Status
+	if status == nil {
+		return capacity
+	}
+	cpu, memory := status.Allocatable["cpu"], status.Allocatable["memory"]
+	if cpu == "" {
+		cpu = status.AllocatableCpu
+	}
+	if memory == "" {
+		memory = status.AllocatableMemory
+	}
+	capacity.AllocatableCpu = MilliCPU(cpu)
+	capacity.AllocatableMemory = Bytes(memory)
+	pods, ok := ParseQuantity(status.Allocatable["pods"])
+	if !ok {
+		pods, _ = ParseQuantity(status.Capacity["pods"])
+	}
+	capacity.AllocatablePods = int32(pods)
+	capacity.Schedulable = !node.Spec.GetUnschedulable() && ready(status)
+	return capacity
+}
+
+func ready(status *types.NodeStatus) bool {
+	for _, condition := range status.Conditions {
+		if condition.Type == "Ready" {
+			return condition.Status == "True"
+		}
+	}
+	return false
+}
+
+// podResources returns the effective requests and limits of a pod, the sum of its containers or the
+// largest init container, whichever is higher, as the scheduler accounts for them.
+func podResources(spec *types.PodSpec) (int64, int64, int64, int64) {
+	var cpu, memory, cpuLimit, memoryLimit int64
+	for _, container := range spec.Containers {
+		resources := container.Resources
+		cpu += MilliCPU(resources.GetRequests()["cpu"])
+		memory += Bytes(resources.GetRequests()["memory"])
+		cpuLimit += MilliCPU(resources.GetLimits()["cpu"])
+		memoryLimit += Bytes(resources.GetLimits()["memory"])
+	}
+	for _, container := range spec.InitContainers {
+		resources := container.Resources
+		cpu = max(cpu, MilliCPU(resources.GetRequests()["cpu"]))
+		memory = max(memory, Bytes(resources.GetRequests()["memory"]))
+		cpuLimit = max(cpuLimit, MilliCPU(resources.GetLimits()["cpu"]))
+		memoryLimit = max(memoryLimit, Bytes(resources.GetLimits()["memory"]))
+	}
+	return cpu, memory, cpuLimit, memoryLimit
+}
+
+func ratios(capacity *types.K8SCapacity) {
+	capacity.CpuRequestRatio = ratio(capacity.RequestedCpu, capacity.AllocatableCpu)
+	capacity.MemoryRequestRatio = ratio(capacity.RequestedMemory, capacity.AllocatableMemory)
+	capacity.CpuOvercommit = ratio(capacity.LimitCpu, capacity.AllocatableCpu)
+	capacity.MemoryOvercommit = ratio(capacity.LimitMemory, capacity.AllocatableMemory)
+}
+
+func ratio(value, of int64) float64 {
+	if of == 0 {
+		return 0
+	}
+	return math.Round(float64(value)/float64(of)*100) / 100
+}
+
+// fit returns how many more pods of the size fit on the node by its free cpu, memory and pod slots,
+// or true when none of them limits the pods.
+func fit(capacity *types.K8SCapacity, podCPU, podMemory int64) (int32, bool) {
+	if capacity.AllocatablePods <= 0 && podCPU <= 0 && podMemory <= 0 {
+		return 0, true
+	}
+	result := int64(math.MaxInt32)
+	if capacity.AllocatablePods > 0 {
+		result = int64(capacity.AllocatablePods - capacity.PodCount)
+	}
+	if podCPU > 0 {
+		result = min(result, (capacity.AllocatableCpu-capacity.RequestedCpu)/podCPU)
+	}
+	if podMemory > 0 {
+		result = min(result, (capacity.AllocatableMemory-capacity.RequestedMemory)/podMemory)
+	}
+	if result < 0 {
+		return 0, false
+	}
+	return int32(min(result, math.MaxInt32)), false
+}
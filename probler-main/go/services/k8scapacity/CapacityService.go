@@ -0,0 +1,119 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8scapacity
+
+import (
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	ServiceName = "K8sCapacity"
+	ServiceArea = byte(1)
+)
+
+// CapacityService computes the node capacity & pod scheduling headroom of the clusters in the k8s
+// cache on demand, from their collected nodes and pods.
+type CapacityService struct {
+	vnic ifs.IVNic
+}
+
+func Activate(vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&CapacityService{}, ServiceName, ServiceArea, false, nil)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ServiceName, ": ", err.Error())
+	}
+}
+
+func (this *CapacityService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	this.vnic = vnic
+	vnic.Resources().Registry().Register(&types.K8SCapacityQuery{})
+	vnic.Resources().Registry().Register(&types.K8SCapacityReportList{})
+	vnic.Resources().Registry().Register(&types.K8SCapacityReport{})
+	vnic.Resources().Registry().Register(&types.K8SCapacity{})
+	return nil
+}
+
+func (this *CapacityService) DeActivate() error {
+	return nil
+}
+
+func (this *CapacityService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Post is not supported by " + ServiceName)
+}
+
+func (this *CapacityService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Put is not supported by " + ServiceName)
+}
+
+func (this *CapacityService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ServiceName)
+}
+
+func (this *CapacityService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Delete is not supported by " + ServiceName)
+}
+
+// Get returns the capacity report of the queried cluster, or of all the clusters.
+func (this *CapacityService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, ok := pb.Element().(*types.K8SCapacityQuery)
+	if !ok {
+		query = &types.K8SCapacityQuery{}
+	}
+	podCPU, podMemory := query.PodCpu, query.PodMemory
+	if podCPU == "" {
+		podCPU = DEFAULT_POD_CPU
+	}
+	if podMemory == "" {
+		podMemory = DEFAULT_POD_MEMORY
+	}
+	if _, ok = ParseQuantity(podCPU); !ok {
+		return object.NewError("Invalid pod cpu " + podCPU)
+	}
+	if _, ok = ParseQuantity(podMemory); !ok {
+		return object.NewError("Invalid pod memory " + podMemory)
+	}
+	clusters, err := common.K8sClusters(this.vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	list := &types.K8SCapacityReportList{}
+	for _, cluster := range clusters {
+		if query.Cluster == "" || query.Cluster == cluster.Name {
+			report := Compute(cluster, MilliCPU(podCPU), Bytes(podMemory))
+			report.PodCpu, report.PodMemory = podCPU, podMemory
+			list.List = append(list.List, report)
+		}
+	}
+	return object.New(nil, list)
+}
+
+func (this *CapacityService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *CapacityService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *CapacityService) WebService() ifs.IWebService {
+	return web.New(ServiceName, ServiceArea, nil, nil, nil, nil, nil, nil, nil, nil,
+		&types.K8SCapacityQuery{}, &types.K8SCapacityReportList{})
+}
@@ -0,0 +1,67 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8scapacity
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+var binarySuffixes = map[string]float64{"Ki": 1 << 10, "Mi": 1 << 20, "Gi": 1 << 30, "Ti": 1 << 40, "Pi": 1 << 50,
+	"Ei": 1 << 60}
+
+var decimalSuffixes = map[string]float64{"n": 1e-9, "u": 1e-6, "m": 1e-3, "k": 1e3, "M": 1e6, "G": 1e9, "T": 1e12,
+	"P": 1e15, "E": 1e18}
+
+// ParseQuantity parses a kubernetes resource quantity, e.g. 500m, 1.5, 16Gi, 129e6 or 128974848,
+// to its value in the base unit.
+func ParseQuantity(quantity string) (float64, bool) {
+	quantity = strings.TrimSpace(quantity)
+	if quantity == "" {
+		return 0, false
+	}
+	multiplier := 1.0
+	if len(quantity) > 2 {
+		if m, ok := binarySuffixes[quantity[len(quantity)-2:]]; ok {
+			multiplier = m
+			quantity = quantity[:len(quantity)-2]
+		}
+	}
+	if multiplier == 1.0 {
+		if m, ok := decimalSuffixes[quantity[len(quantity)-1:]]; ok {
+			multiplier = m
+			quantity = quantity[:len(quantity)-1]
+		}
+	}
+	value, err := strconv.ParseFloat(quantity, 64)
+	if err != nil {
+		return 0, false
+	}
+	return value * multiplier, true
+}
+
+// MilliCPU returns a cpu quantity in millicores, 0 when it can't be parsed.
+func MilliCPU(quantity string) int64 {
+	value, _ := ParseQuantity(quantity)
+	return int64(math.Round(value * 1000))
+}
+
+// Bytes returns a memory quantity in bytes, 0 when it can't be parsed.
+func Bytes(quantity string) int64 {
+	value, _ := ParseQuantity(quantity)
+	return int64(math.Round(value))
+}
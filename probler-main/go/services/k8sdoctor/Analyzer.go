@@ -0,0 +1,161 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8sdoctor
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	DEFAULT_PENDING_MINUTES = 5
+	RESTART_RISE            = 3
+)
+
+// Analyze runs the health rules over the cached tables of a cluster and returns its problem report.
+// Pods pending longer than pendingMinutes are reported, and pods whose restarts rose by RESTART_RISE
+// or more within the restarts window.
+func Analyze(cluster *types.K8SCluster, restarts *Restarts, pendingMinutes int32, now int64) *types.K8SProblemReport {
+	if pendingMinutes <= 0 {
+		pendingMinutes = DEFAULT_PENDING_MINUTES
+	}
+	report := &types.K8SProblemReport{Cluster: cluster.Name, Analyzed: now}
+	for _, node := range cluster.Nodes {
+		if node.Status != types.K8SNodeStatus_Ready {
+			add(report, types.K8SProblemSeverity_ProblemCritical, types.K8SProblemKind_NodeNotReady, "", "Node", node.Name,
+				"Node is not Ready",
+				"kubectl describe node "+node.Name+" and check its conditions",
+				"Check the kubelet and the container runtime on "+node.Name,
+				events(cluster, node.Name))
+		}
+	}
+	for _, pod := range cluster.Pods {
+		analyzePod(report, cluster, pod, restarts, pendingMinutes, now)
+	}
+	for _, deployment := range cluster.Deployments {
+		desired := deployment.Ready.GetOutof()
+		available := deployment.Available.GetCount()
+		if desired > 0 && available < desired {
+			add(report, severity(available), types.K8SProblemKind_DeploymentUnavailable, deployment.Namespace, "Deployment",
+				deployment.Name, strconv.Itoa(int(available))+" of "+strconv.Itoa(int(desired))+" replicas available",
+				"kubectl rollout status deployment/"+deployment.Name+" -n "+deployment.Namespace,
+				"Check the failing pods of the deployment",
+				events(cluster, deployment.Name))
+		} else if upToDate := deployment.UpToDate.GetCount(); desired > 0 && upToDate < desired {
+			add(report, types.K8SProblemSeverity_ProblemInfo, types.K8SProblemKind_DeploymentUnavailable, deployment.Namespace,
+				"Deployment", deployment.Name, "Rollout in progress, "+strconv.Itoa(int(upToDate))+" of "+
+					strconv.Itoa(int(desired))+" replicas up to date",
+				"kubectl rollout status deployment/"+deployment.Name+" -n "+deployment.Namespace)
+		}
+	}
+	for _, statefulset := range cluster.Statefulsets {
+		desired := statefulset.Ready.GetOutof()
+		ready := statefulset.Ready.GetCount()
+		if desired > 0 && ready < desired {
+			add(report, severity(ready), types.K8SProblemKind_StatefulSetUnavailable, statefulset.Namespace, "StatefulSet",
+				statefulset.Name, strconv.Itoa(int(ready))+" of "+strconv.Itoa(int(desired))+" replicas ready",
+				"kubectl rollout status statefulset/"+statefulset.Name+" -n "+statefulset.Namespace,
+				"Check the persistent volume claims of the pods that are not ready",
+				events(cluster, statefulset.Name))
+		}
+	}
+	for _, daemonset := range cluster.Daemonsets {
+		desired := daemonset.Desired.GetCount()
+		ready := daemonset.Ready.GetCount()
+		if desired > 0 && ready < desired {
+			add(report, types.K8SProblemSeverity_ProblemWarning, types.K8SProblemKind_DaemonSetMissing, daemonset.Namespace,
+				"DaemonSet", daemonset.Name, "Ready on "+strconv.Itoa(int(ready))+" of "+strconv.Itoa(int(desired))+" nodes",
+				"kubectl get pods -n "+daemonset.Namespace+" -o wide to find the nodes it is missing on",
+				"Check the taints of those nodes against the daemonset tolerations",
+				events(cluster, daemonset.Name))
+		}
+	}
+	sort.Slice(report.Problems, func(i, j int) bool {
+		a, b := report.Problems[i], report.Problems[j]
+		if a.Severity != b.Severity {
+			return a.Severity > b.Severity
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return report
+}
+
+func analyzePod(report *types.K8SProblemReport, cluster *types.K8SCluster, pod *types.K8SPod, restarts *Restarts,
+	pendingMinutes int32, now int64) {
+	logs := "kubectl logs " + pod.Name + " -n " + pod.Namespace
+	switch pod.Status {
+	case types.K8SPodStatus_CrashLoopBackOff:
+		add(report, types.K8SProblemSeverity_ProblemCritical, types.K8SProblemKind_PodFailing, pod.Namespace, "Pod", pod.Name,
+			"Pod is in CrashLoopBackOff", logs+" --previous",
+			"Check the memory limit if the container was OOMKilled", events(cluster, pod.Name))
+		//Already reported as crashing
+		return
+	case types.K8SPodStatus_ImagePullBackOff:
+		add(report, types.K8SProblemSeverity_ProblemCritical, types.K8SProblemKind_PodFailing, pod.Namespace, "Pod", pod.Name,
+			"Pod can't pull its image", "Verify the image name and tag exist in the registry",
+			"Check the image pull secrets of the pod", events(cluster, pod.Name))
+	case types.K8SPodStatus_Error, types.K8SPodStatus_Failed:
+		add(report, types.K8SProblemSeverity_ProblemWarning, types.K8SProblemKind_PodFailing, pod.Namespace, "Pod", pod.Name,
+			"Pod is in "+pod.Status.String()+" state", logs, events(cluster, pod.Name))
+	case types.K8SPodStatus_Pending:
+		created := pod.Age.GetCreated()
+		if created > 0 && now-created > int64(pendingMinutes)*60 {
+			add(report, types.K8SProblemSeverity_ProblemWarning, types.K8SProblemKind_PodPending, pod.Namespace, "Pod", pod.Name,
+				"Pod is Pending for "+strconv.Itoa(int((now-created)/60))+" minutes",
+				"kubectl describe pod "+pod.Name+" -n "+pod.Namespace,
+				"Check for FailedScheduling events, the node capacity and taints", events(cluster, pod.Name))
+		}
+	}
+	if restarts == nil {
+		return
+	}
+	rise := restarts.Rise(cluster.Name, pod)
+	if rise >= RESTART_RISE {
+		add(report, types.K8SProblemSeverity_ProblemWarning, types.K8SProblemKind_RestartsRising, pod.Namespace, "Pod", pod.Name,
+			"Restarted "+strconv.Itoa(int(rise))+" times recently", logs+" --previous", events(cluster, pod.Name))
+	}
+}
+
+func add(report *types.K8SProblemReport, severity types.K8SProblemSeverity, kind types.K8SProblemKind,
+	namespace, objectKind, name, summary string, nextSteps ...string) {
+	report.Problems = append(report.Problems, &types.K8SProblem{Severity: severity, Kind: kind, Namespace: namespace,
+		ObjectKind: objectKind, Name: name, Summary: summary, NextSteps: nextSteps})
+	switch severity {
+	case types.K8SProblemSeverity_ProblemCritical:
+		report.CriticalCount++
+	case types.K8SProblemSeverity_ProblemWarning:
+		report.WarningCount++
+	default:
+		report.InfoCount++
+	}
+}
+
+// severity of a workload with some or none of its replicas available.
+func severity(available int32) types.K8SProblemSeverity {
+	if available == 0 {
+		return types.K8SProblemSeverity_ProblemCritical
+	}
+	return types.K8SProblemSeverity_ProblemWarning
+}
+
+func events(cluster *types.K8SCluster, name string) string {
+	return "prctl get events " + cluster.Name + " " + name + " to see its recent warnings"
+}
@@ -0,0 +1,132 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8sdoctor
+
+import (
+	"time"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	ServiceName     = "K8sDoctor"
+	ServiceArea     = byte(1)
+	SAMPLE_INTERVAL = time.Minute
+	RESTART_WINDOW  = time.Minute * 15
+)
+
+// DoctorService analyzes the health of the clusters in the k8s cache on demand. It samples the pod
+// restart counts every minute, so the report can tell which pods keep restarting.
+type DoctorService struct {
+	vnic     ifs.IVNic
+	restarts *Restarts
+	running  bool
+}
+
+func Activate(vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&DoctorService{}, ServiceName, ServiceArea, false, nil)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ServiceName, ": ", err.Error())
+	}
+}
+
+func (this *DoctorService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	this.vnic = vnic
+	this.restarts = NewRestarts(RESTART_WINDOW)
+	this.running = true
+	vnic.Resources().Registry().RegisterEnums(types.K8SProblemSeverity_value)
+	vnic.Resources().Registry().RegisterEnums(types.K8SProblemKind_value)
+	vnic.Resources().Registry().Register(&types.K8SDoctorQuery{})
+	vnic.Resources().Registry().Register(&types.K8SProblemReportList{})
+	vnic.Resources().Registry().Register(&types.K8SProblemReport{})
+	vnic.Resources().Registry().Register(&types.K8SProblem{})
+	go this.sample()
+	return nil
+}
+
+func (this *DoctorService) DeActivate() error {
+	this.running = false
+	return nil
+}
+
+func (this *DoctorService) sample() {
+	for this.running {
+		time.Sleep(SAMPLE_INTERVAL)
+		clusters, err := common.K8sClusters(this.vnic)
+		if err != nil {
+			this.vnic.Resources().Logger().Error(ServiceName, " restarts sampling failed: ", err.Error())
+			continue
+		}
+		now := time.Now().Unix()
+		for _, cluster := range clusters {
+			this.restarts.Sample(cluster, now)
+		}
+	}
+}
+
+func (this *DoctorService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Post is not supported by " + ServiceName)
+}
+
+func (this *DoctorService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Put is not supported by " + ServiceName)
+}
+
+func (this *DoctorService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ServiceName)
+}
+
+func (this *DoctorService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Delete is not supported by " + ServiceName)
+}
+
+// Get returns the problem report of the queried cluster, or of all the clusters.
+func (this *DoctorService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, ok := pb.Element().(*types.K8SDoctorQuery)
+	if !ok {
+		query = &types.K8SDoctorQuery{}
+	}
+	clusters, err := common.K8sClusters(this.vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	now := time.Now().Unix()
+	list := &types.K8SProblemReportList{}
+	for _, cluster := range clusters {
+		if query.Cluster == "" || query.Cluster == cluster.Name {
+			list.List = append(list.List, Analyze(cluster, this.restarts, query.PendingMinutes, now))
+		}
+	}
+	return object.New(nil, list)
+}
+
+func (this *DoctorService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *DoctorService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *DoctorService) WebService() ifs.IWebService {
+	return web.New(ServiceName, ServiceArea, nil, nil, nil, nil, nil, nil, nil, nil,
+		&types.K8SDoctorQuery{}, &types.K8SProblemReportList{})
+}
@@ -0,0 +1,84 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8sdoctor
+
+import (
+	"sync"
+	"time"
+
+	"github.com/saichler/probler/go/types"
+)
+
+// Restarts keeps the restart counts of the pods sampled within a time window, so a pod whose restarts
+// keep rising can be told apart from a pod that restarted a lot a long time ago.
+type Restarts struct {
+	samples map[string]map[string][]*sample
+	window  int64
+	mtx     *sync.RWMutex
+}
+
+type sample struct {
+	time  int64
+	count int32
+}
+
+func NewRestarts(window time.Duration) *Restarts {
+	return &Restarts{samples: make(map[string]map[string][]*sample), window: int64(window.Seconds()),
+		mtx: &sync.RWMutex{}}
+}
+
+// Sample adds the current restart counts of the cluster pods, dropping the samples older than the
+// window and the pods that are gone.
+func (this *Restarts) Sample(cluster *types.K8SCluster, now int64) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	old := this.samples[cluster.Name]
+	pods := make(map[string][]*sample, len(cluster.Pods))
+	for _, pod := range cluster.Pods {
+		if pod.Restarts == nil {
+			continue
+		}
+		key := podKey(pod)
+		samples := make([]*sample, 0, len(old[key])+1)
+		for _, s := range old[key] {
+			if now-s.time <= this.window {
+				samples = append(samples, s)
+			}
+		}
+		pods[key] = append(samples, &sample{time: now, count: pod.Restarts.Count})
+	}
+	this.samples[cluster.Name] = pods
+}
+
+// Rise returns how many times the pod restarted within the window, 0 when it was replaced by a new
+// pod with the same name and the count started over.
+func (this *Restarts) Rise(cluster string, pod *types.K8SPod) int32 {
+	this.mtx.RLock()
+	defer this.mtx.RUnlock()
+	samples := this.samples[cluster][podKey(pod)]
+	if len(samples) < 2 {
+		return 0
+	}
+	rise := samples[len(samples)-1].count - samples[0].count
+	if rise < 0 {
+		return 0
+	}
+	return rise
+}
+
+func podKey(pod *types.K8SPod) string {
+	return pod.Namespace + "/" + pod.Name
+}
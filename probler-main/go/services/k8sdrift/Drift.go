@@ -0,0 +1,177 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8sdrift
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// entry is the comparable state of a workload, service or network policy.
+type entry struct {
+	kind     string
+	name     string
+	images   string
+	replicas string
+	spec     string
+	policy   *types.NetworkPolicySpec
+}
+
+// Diff compares the workloads, services and network policies of two clusters, or of a namespace in
+// each of them, by name. The cluster IPs, the node ports and the daemonset sizes depend on the
+// cluster and are not compared.
+func Diff(left, right *types.K8SCluster, leftNamespace, rightNamespace string) *types.K8SDrift {
+	if rightNamespace == "" {
+		rightNamespace = leftNamespace
+	}
+	drift := &types.K8SDrift{Left: label(left.Name, leftNamespace), Right: label(right.Name, rightNamespace)}
+	leftEntries := entries(left, leftNamespace)
+	rightEntries := entries(right, rightNamespace)
+	for key, l := range leftEntries {
+		r, ok := rightEntries[key]
+		if !ok {
+			addItem(drift, types.K8SDriftKind_OnlyInLeft, l, "", "")
+			continue
+		}
+		matched := true
+		if l.images != r.images {
+			addItem(drift, types.K8SDriftKind_ImageDrift, l, l.images, r.images)
+			matched = false
+		}
+		if l.replicas != r.replicas {
+			addItem(drift, types.K8SDriftKind_ReplicaDrift, l, l.replicas, r.replicas)
+			matched = false
+		}
+		if l.spec != r.spec || !proto.Equal(l.policy, r.policy) {
+			kind := types.K8SDriftKind_ServiceDrift
+			if l.kind == "NetworkPolicy" {
+				kind = types.K8SDriftKind_NetworkPolicyDrift
+			}
+			addItem(drift, kind, l, l.display(), r.display())
+			matched = false
+		}
+		if matched {
+			drift.MatchCount++
+		}
+	}
+	for key, r := range rightEntries {
+		if _, ok := leftEntries[key]; !ok {
+			addItem(drift, types.K8SDriftKind_OnlyInRight, r, "", "")
+		}
+	}
+	sort.Slice(drift.Items, func(i, j int) bool {
+		a, b := drift.Items[i], drift.Items[j]
+		if a.ObjectKind != b.ObjectKind {
+			return a.ObjectKind < b.ObjectKind
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Kind < b.Kind
+	})
+	return drift
+}
+
+// entries returns the comparable entries of a cluster keyed by kind and name. The name is
+// <namespace>/<name> when the whole cluster is compared.
+func entries(cluster *types.K8SCluster, namespace string) map[string]*entry {
+	result := make(map[string]*entry)
+	put := func(e *entry, ns string) {
+		if namespace != "" && ns != namespace {
+			return
+		}
+		if namespace == "" {
+			e.name = ns + "/" + e.name
+		}
+		result[e.kind+" "+e.name] = e
+	}
+	for _, d := range cluster.Deployments {
+		put(&entry{kind: "Deployment", name: d.Name, images: images(d.Images),
+			replicas: strconv.Itoa(int(d.Ready.GetOutof()))}, d.Namespace)
+	}
+	for _, s := range cluster.Statefulsets {
+		put(&entry{kind: "StatefulSet", name: s.Name, images: images(s.Images),
+			replicas: strconv.Itoa(int(s.Ready.GetOutof()))}, s.Namespace)
+	}
+	for _, d := range cluster.Daemonsets {
+		put(&entry{kind: "DaemonSet", name: d.Name, images: images(d.Images)}, d.Namespace)
+	}
+	for _, s := range cluster.Services {
+		put(&entry{kind: "Service", name: s.Name, spec: s.Type + " " + ports(s.Ports)}, s.Namespace)
+	}
+	for _, p := range cluster.GetObjects().GetNetworkpolicies() {
+		put(&entry{kind: "NetworkPolicy", name: p.GetMetadata().GetName(), policy: p.Spec},
+			p.GetMetadata().GetNamespace())
+	}
+	return result
+}
+
+// images returns the comma separated images in a stable order.
+func images(value string) string {
+	list := strings.Split(value, ",")
+	for i, image := range list {
+		list[i] = strings.TrimSpace(image)
+	}
+	sort.Strings(list)
+	return strings.Join(list, ",")
+}
+
+// ports returns the comma separated service ports without their node ports, e.g. 80/TCP of
+// 80:31234/TCP, in a stable order.
+func ports(value string) string {
+	list := strings.Split(value, ",")
+	for i, port := range list {
+		port = strings.TrimSpace(port)
+		colon := strings.Index(port, ":")
+		slash := strings.Index(port, "/")
+		if colon != -1 && slash > colon {
+			port = port[:colon] + port[slash:]
+		}
+		list[i] = port
+	}
+	sort.Strings(list)
+	return strings.Join(list, ",")
+}
+
+// display returns the compared spec of the entry, a network policy spec as json. The json is only
+// shown, as its format is not stable.
+func (this *entry) display() string {
+	if this.policy == nil {
+		return this.spec
+	}
+	data, err := protojson.Marshal(this.policy)
+	if err != nil {
+		return err.Error()
+	}
+	return string(data)
+}
+
+func addItem(drift *types.K8SDrift, kind types.K8SDriftKind, e *entry, left, right string) {
+	drift.Items = append(drift.Items, &types.K8SDriftItem{Kind: kind, ObjectKind: e.kind, Name: e.name,
+		Left: left, Right: right})
+}
+
+func label(cluster, namespace string) string {
+	if namespace == "" {
+		return cluster
+	}
+	return cluster + "/" + namespace
+}
@@ -0,0 +1,112 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8sdrift
+
+import (
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	ServiceName = "K8sDrift"
+	ServiceArea = byte(1)
+)
+
+// DriftService compares two clusters, or two namespaces, of the k8s cache on demand.
+type DriftService struct {
+	vnic ifs.IVNic
+}
+
+func Activate(vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&DriftService{}, ServiceName, ServiceArea, false, nil)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ServiceName, ": ", err.Error())
+	}
+}
+
+func (this *DriftService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	this.vnic = vnic
+	vnic.Resources().Registry().RegisterEnums(types.K8SDriftKind_value)
+	vnic.Resources().Registry().Register(&types.K8SDriftQuery{})
+	vnic.Resources().Registry().Register(&types.K8SDrift{})
+	vnic.Resources().Registry().Register(&types.K8SDriftItem{})
+	return nil
+}
+
+func (this *DriftService) DeActivate() error {
+	return nil
+}
+
+func (this *DriftService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Post is not supported by " + ServiceName)
+}
+
+func (this *DriftService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Put is not supported by " + ServiceName)
+}
+
+func (this *DriftService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ServiceName)
+}
+
+func (this *DriftService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Delete is not supported by " + ServiceName)
+}
+
+// Get returns the drift between the two queried clusters or namespaces.
+func (this *DriftService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, ok := pb.Element().(*types.K8SDriftQuery)
+	if !ok || query.LeftCluster == "" || query.RightCluster == "" {
+		return object.NewError("Expected a drift query with two clusters")
+	}
+	clusters, err := common.K8sClusters(this.vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	var left, right *types.K8SCluster
+	for _, cluster := range clusters {
+		if cluster.Name == query.LeftCluster {
+			left = cluster
+		}
+		if cluster.Name == query.RightCluster {
+			right = cluster
+		}
+	}
+	if left == nil {
+		return object.NewError("Unknown cluster " + query.LeftCluster)
+	}
+	if right == nil {
+		return object.NewError("Unknown cluster " + query.RightCluster)
+	}
+	return object.New(nil, Diff(left, right, query.LeftNamespace, query.RightNamespace))
+}
+
+func (this *DriftService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *DriftService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *DriftService) WebService() ifs.IWebService {
+	return web.New(ServiceName, ServiceArea, nil, nil, nil, nil, nil, nil, nil, nil,
+		&types.K8SDriftQuery{}, &types.K8SDrift{})
+}
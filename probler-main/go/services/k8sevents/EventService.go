@@ -0,0 +1,129 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8sevents
+
+import (
+	"time"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/k8sobjects"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	ServiceName            = "K8sEvents"
+	ServiceArea            = byte(1)
+	COLLECT_INTERVAL       = time.Second * 30
+	MAX_EVENTS_PER_CLUSTER = 1000
+	K8S_EVENTS_POLLARIS    = "kubernetes-events"
+)
+
+// WarningEvents is the kubectl job tailing the warning events, e.g. BackOff, FailedScheduling,
+// OOMKilling and Evicted, of a cluster.
+var WarningEvents = &k8sobjects.Resource{Name: "events", Field: "events", Namespaced: true, Selector: "type=Warning"}
+
+// EventService tails the warning events of every cluster in the k8s cache into a bounded event
+// store, linking each event to the pod, node and workload it references, and serves event queries.
+type EventService struct {
+	vnic    ifs.IVNic
+	store   *Store
+	running bool
+}
+
+func Activate(vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&EventService{}, ServiceName, ServiceArea, false, nil)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ServiceName, ": ", err.Error())
+	}
+}
+
+func (this *EventService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	this.vnic = vnic
+	this.store = NewStore(MAX_EVENTS_PER_CLUSTER)
+	this.running = true
+	vnic.Resources().Registry().Register(&types.K8SEventQuery{})
+	vnic.Resources().Registry().Register(&types.K8SEventList{})
+	vnic.Resources().Registry().Register(&types.K8SEvent{})
+	go this.collect()
+	return nil
+}
+
+func (this *EventService) DeActivate() error {
+	this.running = false
+	return nil
+}
+
+func (this *EventService) collect() {
+	for this.running {
+		time.Sleep(COLLECT_INTERVAL)
+		clusters, err := common.K8sClusters(this.vnic)
+		if err != nil {
+			this.vnic.Resources().Logger().Error(ServiceName, " collection failed: ", err.Error())
+			continue
+		}
+		for _, cluster := range clusters {
+			objects := &types.K8SObjects{}
+			data, err := k8sobjects.Exec(this.vnic, cluster.Name, K8S_EVENTS_POLLARIS, WarningEvents.Name)
+			if err == nil {
+				_, err = k8sobjects.Map(data, objects, WarningEvents)
+			}
+			if err != nil {
+				this.vnic.Resources().Logger().Error(ServiceName, " failed to collect the events of ", cluster.Name, ": ", err.Error())
+				continue
+			}
+			this.store.Add(cluster.Name, Events(cluster, objects.Events))
+		}
+	}
+}
+
+func (this *EventService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Post is not supported by " + ServiceName)
+}
+
+func (this *EventService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Put is not supported by " + ServiceName)
+}
+
+func (this *EventService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ServiceName)
+}
+
+func (this *EventService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Delete is not supported by " + ServiceName)
+}
+
+// Get returns the stored events matching the query, the most recent first.
+func (this *EventService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, _ := pb.Element().(*types.K8SEventQuery)
+	return object.New(nil, this.store.Query(query))
+}
+
+func (this *EventService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *EventService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *EventService) WebService() ifs.IWebService {
+	return web.New(ServiceName, ServiceArea, nil, nil, nil, nil, nil, nil, nil, nil,
+		&types.K8SEventQuery{}, &types.K8SEventList{})
+}
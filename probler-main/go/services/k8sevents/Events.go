@@ -0,0 +1,133 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8sevents
+
+import (
+	"time"
+
+	"github.com/saichler/probler/go/types"
+)
+
+// Events converts the collected API events of a cluster to event records, keyed by the event key,
+// and links each of them to the pod, node and workload it references in the cluster's cache.
+func Events(cluster *types.K8SCluster, events map[string]*types.Event) map[string]*types.K8SEvent {
+	result := make(map[string]*types.K8SEvent, len(events))
+	for key, event := range events {
+		record := &types.K8SEvent{Cluster: cluster.Name, Key: key, Type: event.Type, Reason: event.Reason,
+			Message: event.Message, Count: event.Count}
+		if event.Metadata != nil {
+			record.Namespace = event.Metadata.Namespace
+		}
+		record.LastSeen = timestamp(event.LastTimestamp, event.EventTime)
+		record.FirstSeen = timestamp(event.FirstTimestamp, event.EventTime)
+		if record.FirstSeen == 0 {
+			record.FirstSeen = record.LastSeen
+		}
+		if record.Count == 0 {
+			record.Count = 1
+		}
+		if event.InvolvedObject != nil {
+			Link(record, event.InvolvedObject, cluster)
+		}
+		result[key] = record
+	}
+	return result
+}
+
+// Link sets the pod, node and workload of the event from the object it references. The node of a pod
+// and the owner of a workload are looked up in the collected objects, falling back to the pods table.
+func Link(record *types.K8SEvent, ref *types.ObjectReference, cluster *types.K8SCluster) {
+	record.ObjectKind = ref.Kind
+	record.ObjectName = ref.Name
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = record.Namespace
+	}
+	objects := cluster.Objects
+	if objects == nil {
+		objects = &types.K8SObjects{}
+	}
+	switch ref.Kind {
+	case "Pod":
+		record.Pod = ref.Name
+		pod := objects.Pods[namespace+"/"+ref.Name]
+		if pod != nil {
+			if pod.Spec != nil {
+				record.Node = pod.Spec.NodeName
+			}
+			owner := controller(pod.Metadata)
+			if owner != nil {
+				record.WorkloadKind, record.WorkloadName = workload(objects, namespace, owner.Kind, owner.Name)
+			}
+			return
+		}
+		for _, p := range cluster.Pods {
+			if p.Namespace == namespace && p.Name == ref.Name {
+				record.Node = p.Node
+				return
+			}
+		}
+	case "Node":
+		record.Node = ref.Name
+	case "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "Job", "CronJob":
+		record.WorkloadKind, record.WorkloadName = workload(objects, namespace, ref.Kind, ref.Name)
+	}
+}
+
+// workload resolves a pod owner to the top level workload, a ReplicaSet to its Deployment
+// and a Job to its CronJob.
+func workload(objects *types.K8SObjects, namespace, kind, name string) (string, string) {
+	var owner *types.OwnerReference
+	switch kind {
+	case "ReplicaSet":
+		rs := objects.Replicasets[namespace+"/"+name]
+		if rs != nil {
+			owner = controller(rs.Metadata)
+		}
+	case "Job":
+		job := objects.Jobs[namespace+"/"+name]
+		if job != nil {
+			owner = controller(job.Metadata)
+		}
+	}
+	if owner != nil {
+		return owner.Kind, owner.Name
+	}
+	return kind, name
+}
+
+func controller(metadata *types.ObjectMeta) *types.OwnerReference {
+	if metadata == nil {
+		return nil
+	}
+	for _, owner := range metadata.OwnerReferences {
+		if owner.Controller {
+			return owner
+		}
+	}
+	return nil
+}
+
+// timestamp returns the unix seconds of the first parsable API timestamp, or 0.
+func timestamp(values ...string) int64 {
+	for _, value := range values {
+		t, err := time.Parse(time.RFC3339, value)
+		if err == nil {
+			return t.Unix()
+		}
+	}
+	return 0
+}
@@ -0,0 +1,120 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8sevents
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/saichler/probler/go/types"
+)
+
+// Store keeps the most recent events of every cluster, at most max events per cluster. An event
+// collected again replaces the previous copy of it, so its count and last seen time are updated.
+type Store struct {
+	clusters map[string]map[string]*types.K8SEvent
+	max      int
+	mtx      *sync.RWMutex
+}
+
+func NewStore(max int) *Store {
+	return &Store{clusters: make(map[string]map[string]*types.K8SEvent), max: max, mtx: &sync.RWMutex{}}
+}
+
+// Add adds the events of a cluster, dropping the least recently seen events over the bound.
+func (this *Store) Add(cluster string, events map[string]*types.K8SEvent) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	stored, ok := this.clusters[cluster]
+	if !ok {
+		stored = make(map[string]*types.K8SEvent)
+		this.clusters[cluster] = stored
+	}
+	for key, event := range events {
+		stored[key] = event
+	}
+	if len(stored) <= this.max {
+		return
+	}
+	list := sorted(stored)
+	for _, event := range list[this.max:] {
+		delete(stored, event.Key)
+	}
+}
+
+// Query returns the events matching the query, the most recent first.
+func (this *Store) Query(query *types.K8SEventQuery) *types.K8SEventList {
+	if query == nil {
+		query = &types.K8SEventQuery{}
+	}
+	this.mtx.RLock()
+	matched := make(map[string]*types.K8SEvent)
+	for cluster, events := range this.clusters {
+		if query.Cluster != "" && query.Cluster != cluster {
+			continue
+		}
+		for key, event := range events {
+			if match(event, query) {
+				matched[cluster+"/"+key] = event
+			}
+		}
+	}
+	this.mtx.RUnlock()
+	list := sorted(matched)
+	if query.Limit > 0 && len(list) > int(query.Limit) {
+		list = list[:query.Limit]
+	}
+	return &types.K8SEventList{List: list}
+}
+
+func match(event *types.K8SEvent, query *types.K8SEventQuery) bool {
+	if query.Namespace != "" && query.Namespace != event.Namespace {
+		return false
+	}
+	if query.Reason != "" && !strings.EqualFold(query.Reason, event.Reason) {
+		return false
+	}
+	if query.Type != "" && !strings.EqualFold(query.Type, event.Type) {
+		return false
+	}
+	if query.Since > 0 && event.LastSeen < query.Since {
+		return false
+	}
+	if query.Object != "" && query.Object != event.ObjectName && query.Object != event.Pod &&
+		query.Object != event.Node && query.Object != event.WorkloadName {
+		return false
+	}
+	return true
+}
+
+// sorted returns the events by last seen time, the most recent first.
+func sorted(events map[string]*types.K8SEvent) []*types.K8SEvent {
+	list := make([]*types.K8SEvent, 0, len(events))
+	for _, event := range events {
+		list = append(list, event)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].LastSeen != list[j].LastSeen {
+			return list[i].LastSeen > list[j].LastSeen
+		}
+		if list[i].Cluster != list[j].Cluster {
+			return list[i].Cluster < list[j].Cluster
+		}
+		return list[i].Key < list[j].Key
+	})
+	return list
+}
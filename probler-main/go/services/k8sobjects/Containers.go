@@ -0,0 +1,100 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8sobjects
+
+import (
+	"sort"
+
+	"github.com/saichler/probler/go/types"
+)
+
+// Containers lists the containers, including the init containers, of the pods of the given clusters
+// that match the query, e.g. all the containers that have no memory limit.
+func Containers(clusters []*types.K8SCluster, query *types.K8SContainerQuery) *types.K8SContainerList {
+	if query == nil {
+		query = &types.K8SContainerQuery{}
+	}
+	list := &types.K8SContainerList{List: make([]*types.K8SContainer, 0)}
+	for _, cluster := range clusters {
+		if cluster == nil || cluster.Objects == nil {
+			continue
+		}
+		if query.Cluster != "" && query.Cluster != cluster.Name {
+			continue
+		}
+		for _, pod := range cluster.Objects.Pods {
+			if pod == nil || pod.Metadata == nil || pod.Spec == nil {
+				continue
+			}
+			if query.Namespace != "" && query.Namespace != pod.Metadata.Namespace {
+				continue
+			}
+			for _, container := range pod.Spec.InitContainers {
+				add(list, cluster.Name, pod, container, true, query)
+			}
+			for _, container := range pod.Spec.Containers {
+				add(list, cluster.Name, pod, container, false, query)
+			}
+		}
+	}
+	sort.Slice(list.List, func(i, j int) bool {
+		a, b := list.List[i], list.List[j]
+		if a.Cluster != b.Cluster {
+			return a.Cluster < b.Cluster
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Pod != b.Pod {
+			return a.Pod < b.Pod
+		}
+		if a.Init != b.Init {
+			return a.Init
+		}
+		return a.Name < b.Name
+	})
+	return list
+}
+
+func add(list *types.K8SContainerList, cluster string, pod *types.Pod, container *types.Container, init bool, query *types.K8SContainerQuery) {
+	if container == nil {
+		return
+	}
+	limits := map[string]string{}
+	requests := map[string]string{}
+	if container.Resources != nil {
+		if container.Resources.Limits != nil {
+			limits = container.Resources.Limits
+		}
+		if container.Resources.Requests != nil {
+			requests = container.Resources.Requests
+		}
+	}
+	if query.MissingLimit != "" && limits[query.MissingLimit] != "" {
+		return
+	}
+	if query.MissingRequest != "" && requests[query.MissingRequest] != "" {
+		return
+	}
+	list.List = append(list.List, &types.K8SContainer{Cluster: cluster,
+		Namespace: pod.Metadata.Namespace,
+		Pod:       pod.Metadata.Name,
+		Name:      container.Name,
+		Image:     container.Image,
+		Init:      init,
+		Limits:    limits,
+		Requests:  requests})
+}
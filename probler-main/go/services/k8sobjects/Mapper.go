@@ -0,0 +1,271 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8sobjects
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const LAST_APPLIED_ANNOTATION = "kubectl.kubernetes.io/last-applied-configuration"
+
+// Map parses the output of kubectl get <resource> -o json and adds its items to the map of the
+// resource in objects. The kubernetes.proto messages follow the kubernetes API field names, but
+// some of the API fields are int-or-string or differ in type, so values are converted where
+// possible and skipped where not, instead of failing the whole list.
+func Map(data []byte, objects *types.K8SObjects, resource *Resource) (int, error) {
+	fd := objects.ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(resource.Field))
+	if fd == nil || !fd.IsMap() {
+		return 0, errors.New("Unknown k8s objects field " + resource.Field)
+	}
+	//The job output may contain text before the json document
+	start := bytes.IndexByte(data, '{')
+	if start == -1 {
+		return 0, errors.New("No json in the output of " + resource.Name)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data[start:]))
+	decoder.UseNumber()
+	list := map[string]interface{}{}
+	err := decoder.Decode(&list)
+	if err != nil {
+		return 0, err
+	}
+	items, ok := list["items"].([]interface{})
+	if !ok {
+		//A single object, e.g. kubectl get pod <name> -o json
+		items = []interface{}{list}
+	}
+
+	m := objects.ProtoReflect().Mutable(fd).Map()
+	count := 0
+	for _, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key := Key(obj)
+		if key == "" {
+			continue
+		}
+		if resource.MetadataOnly {
+			obj = metadataOnly(obj)
+		}
+		value := m.NewValue()
+		setMessage(value.Message(), obj)
+		m.Set(protoreflect.ValueOfString(key).MapKey(), value)
+		count++
+	}
+	return count, nil
+}
+
+// Key returns the key of an API object, <namespace>/<name> or just the name for cluster scoped objects.
+func Key(obj map[string]interface{}) string {
+	metadata, ok := obj["metadata"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	name, _ := metadata["name"].(string)
+	if name == "" {
+		return ""
+	}
+	namespace, _ := metadata["namespace"].(string)
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
+
+// metadataOnly drops everything but the type & metadata of an object, including the last applied
+// configuration annotation as it holds a copy of the whole object.
+func metadataOnly(obj map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{"apiVersion": obj["apiVersion"], "kind": obj["kind"]}
+	metadata, ok := obj["metadata"].(map[string]interface{})
+	if !ok {
+		return result
+	}
+	stripped := make(map[string]interface{}, len(metadata))
+	for key, value := range metadata {
+		stripped[key] = value
+	}
+	annotations, ok := metadata["annotations"].(map[string]interface{})
+	if ok {
+		kept := make(map[string]interface{}, len(annotations))
+		for key, value := range annotations {
+			if key != LAST_APPLIED_ANNOTATION {
+				kept[key] = value
+			}
+		}
+		stripped["annotations"] = kept
+	}
+	result["metadata"] = stripped
+	return result
+}
+
+func setMessage(msg protoreflect.Message, obj map[string]interface{}) {
+	fields := msg.Descriptor().Fields()
+	for name, value := range obj {
+		fd := field(fields, name)
+		if fd == nil || value == nil {
+			continue
+		}
+		switch {
+		case fd.IsMap():
+			setMap(msg, fd, value)
+		case fd.IsList():
+			setList(msg, fd, value)
+		case fd.Kind() == protoreflect.MessageKind:
+			child, ok := value.(map[string]interface{})
+			if ok {
+				setMessage(msg.Mutable(fd).Message(), child)
+			}
+		default:
+			v, ok := scalar(fd, value)
+			if ok {
+				msg.Set(fd, v)
+			}
+		}
+	}
+}
+
+func setList(msg protoreflect.Message, fd protoreflect.FieldDescriptor, value interface{}) {
+	elements, ok := value.([]interface{})
+	if !ok {
+		return
+	}
+	list := msg.Mutable(fd).List()
+	for _, element := range elements {
+		if fd.Kind() == protoreflect.MessageKind {
+			obj, ok := element.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			v := list.NewElement()
+			setMessage(v.Message(), obj)
+			list.Append(v)
+			continue
+		}
+		v, ok := scalar(fd, element)
+		if ok {
+			list.Append(v)
+		}
+	}
+}
+
+func setMap(msg protoreflect.Message, fd protoreflect.FieldDescriptor, value interface{}) {
+	obj, ok := value.(map[string]interface{})
+	if !ok || fd.MapKey().Kind() != protoreflect.StringKind {
+		return
+	}
+	m := msg.Mutable(fd).Map()
+	for key, element := range obj {
+		if fd.MapValue().Kind() == protoreflect.MessageKind {
+			child, ok := element.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			v := m.NewValue()
+			setMessage(v.Message(), child)
+			m.Set(protoreflect.ValueOfString(key).MapKey(), v)
+			continue
+		}
+		v, ok := scalar(fd.MapValue(), element)
+		if ok {
+			m.Set(protoreflect.ValueOfString(key).MapKey(), v)
+		}
+	}
+}
+
+// field finds the field of an API attribute, by its json name first and then ignoring case and
+// underscores, e.g. podIPs -> pod_ips.
+func field(fields protoreflect.FieldDescriptors, name string) protoreflect.FieldDescriptor {
+	fd := fields.ByJSONName(name)
+	if fd != nil {
+		return fd
+	}
+	normalized := strings.ToLower(name)
+	for i := 0; i < fields.Len(); i++ {
+		candidate := fields.Get(i)
+		if strings.ReplaceAll(string(candidate.Name()), "_", "") == normalized {
+			return candidate
+		}
+	}
+	return nil
+}
+
+func scalar(fd protoreflect.FieldDescriptor, value interface{}) (protoreflect.Value, bool) {
+	str := ""
+	switch v := value.(type) {
+	case string:
+		str = v
+	case json.Number:
+		str = v.String()
+	case bool:
+		str = strconv.FormatBool(v)
+	default:
+		return protoreflect.Value{}, false
+	}
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(str), true
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(str)
+		return protoreflect.ValueOfBool(b), err == nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, ok := integer(str)
+		return protoreflect.ValueOfInt32(int32(n)), ok
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, ok := integer(str)
+		return protoreflect.ValueOfInt64(n), ok
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, ok := integer(str)
+		return protoreflect.ValueOfUint32(uint32(n)), ok && n >= 0
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, ok := integer(str)
+		return protoreflect.ValueOfUint64(uint64(n)), ok && n >= 0
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(str, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err == nil
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(str, 64)
+		return protoreflect.ValueOfFloat64(f), err == nil
+	case protoreflect.EnumKind:
+		ev := fd.Enum().Values().ByName(protoreflect.Name(str))
+		if ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), true
+		}
+		n, ok := integer(str)
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), ok
+	}
+	return protoreflect.Value{}, false
+}
+
+func integer(str string) (int64, bool) {
+	n, err := strconv.ParseInt(str, 10, 64)
+	if err == nil {
+		return n, true
+	}
+	f, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return 0, false
+	}
+	return int64(f), true
+}
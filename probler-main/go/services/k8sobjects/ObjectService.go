@@ -0,0 +1,157 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8sobjects
+
+import (
+	"errors"
+	"time"
+
+	"github.com/saichler/l8pollaris/go/types/l8tpollaris"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	ServiceName          = "K8sObjs"
+	ServiceArea          = byte(1)
+	COLLECT_INTERVAL     = time.Minute * 5
+	K8S_OBJECTS_POLLARIS = "kubernetes-objects"
+	EXEC_SERVICE_NAME    = "exec"
+	EXEC_SERVICE_AREA    = byte(0)
+)
+
+// ObjectService periodically collects the full API objects of every cluster in the k8s cache,
+// by running the kubectl get -o json jobs through the exec service, and patches them into the
+// cluster's objects. It also serves container queries over the collected pods.
+type ObjectService struct {
+	vnic    ifs.IVNic
+	running bool
+}
+
+func Activate(vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&ObjectService{}, ServiceName, ServiceArea, false, nil)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ServiceName, ": ", err.Error())
+	}
+}
+
+func (this *ObjectService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	this.vnic = vnic
+	this.running = true
+	vnic.Resources().Registry().Register(&l8tpollaris.CJob{})
+	vnic.Resources().Registry().Register(&types.K8SContainerQuery{})
+	vnic.Resources().Registry().Register(&types.K8SContainerList{})
+	go this.collect()
+	return nil
+}
+
+func (this *ObjectService) DeActivate() error {
+	this.running = false
+	return nil
+}
+
+func (this *ObjectService) collect() {
+	for this.running {
+		time.Sleep(COLLECT_INTERVAL)
+		clusters, err := common.K8sClusters(this.vnic)
+		if err != nil {
+			this.vnic.Resources().Logger().Error(ServiceName, " collection failed: ", err.Error())
+			continue
+		}
+		for _, cluster := range clusters {
+			objects := this.collectCluster(cluster.Name)
+			resp := this.vnic.Request("", common.K8s_Cache_Service_Name, common.K8s_Cache_Service_Area, ifs.PATCH,
+				&types.K8SCluster{Name: cluster.Name, Objects: objects}, common.INVENTORY_REQUEST_TIMEOUT)
+			if resp != nil && resp.Error() != nil {
+				this.vnic.Resources().Logger().Error(ServiceName, " failed to update ", cluster.Name, ": ", resp.Error().Error())
+			}
+		}
+	}
+}
+
+func (this *ObjectService) collectCluster(cluster string) *types.K8SObjects {
+	objects := &types.K8SObjects{}
+	for _, resource := range Resources {
+		data, err := Exec(this.vnic, cluster, K8S_OBJECTS_POLLARIS, resource.Name)
+		if err == nil {
+			_, err = Map(data, objects, resource)
+		}
+		if err != nil {
+			this.vnic.Resources().Logger().Error(ServiceName, " failed to collect ", resource.Name, " of ", cluster, ": ", err.Error())
+		}
+	}
+	objects.Collected = time.Now().Unix()
+	return objects
+}
+
+// Exec runs a job of a kubectl pollaris on the cluster through the exec service and returns its output.
+func Exec(vnic ifs.IVNic, cluster, pollarisName, jobName string) ([]byte, error) {
+	job := &l8tpollaris.CJob{TargetId: cluster, HostId: cluster, PollarisName: pollarisName, JobName: jobName}
+	resp := vnic.Request("", EXEC_SERVICE_NAME, EXEC_SERVICE_AREA, ifs.POST, job, common.INVENTORY_REQUEST_TIMEOUT)
+	if resp == nil {
+		return nil, errors.New("No response from " + EXEC_SERVICE_NAME)
+	}
+	if resp.Error() != nil {
+		return nil, resp.Error()
+	}
+	job, ok := resp.Element().(*l8tpollaris.CJob)
+	if !ok {
+		return nil, errors.New("Unexpected response type from " + EXEC_SERVICE_NAME)
+	}
+	return job.Result, nil
+}
+
+func (this *ObjectService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Post is not supported by " + ServiceName)
+}
+
+func (this *ObjectService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Put is not supported by " + ServiceName)
+}
+
+func (this *ObjectService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ServiceName)
+}
+
+func (this *ObjectService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Delete is not supported by " + ServiceName)
+}
+
+func (this *ObjectService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, _ := pb.Element().(*types.K8SContainerQuery)
+	clusters, err := common.K8sClusters(this.vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, Containers(clusters, query))
+}
+
+func (this *ObjectService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *ObjectService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *ObjectService) WebService() ifs.IWebService {
+	return web.New(ServiceName, ServiceArea, nil, nil, nil, nil, nil, nil, nil, nil,
+		&types.K8SContainerQuery{}, &types.K8SContainerList{})
+}
@@ -0,0 +1,65 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8sobjects
+
+// Resource is a kubernetes resource collected with kubectl get <Name> -o json, its items are
+// stored in the K8SObjects map named Field. Only the metadata is kept for MetadataOnly resources,
+// and only the items matching the Selector field selector are collected when it is set.
+type Resource struct {
+	Name         string
+	Field        string
+	Namespaced   bool
+	MetadataOnly bool
+	Selector     string
+}
+
+var Resources = []*Resource{
+	{Name: "pods", Field: "pods", Namespaced: true},
+	{Name: "deployments", Field: "deployments", Namespaced: true},
+	{Name: "statefulsets", Field: "statefulsets", Namespaced: true},
+	{Name: "daemonsets", Field: "daemonsets", Namespaced: true},
+	{Name: "services", Field: "services", Namespaced: true},
+	{Name: "ingresses", Field: "ingresses", Namespaced: true},
+	{Name: "nodes", Field: "nodes"},
+	{Name: "namespaces", Field: "namespaces"},
+	{Name: "networkpolicies", Field: "networkpolicies", Namespaced: true},
+	{Name: "persistentvolumes", Field: "persistentvolumes"},
+	{Name: "roles", Field: "roles", Namespaced: true},
+	{Name: "clusterroles", Field: "clusterroles"},
+	{Name: "rolebindings", Field: "rolebindings", Namespaced: true},
+	{Name: "clusterrolebindings", Field: "clusterrolebindings"},
+	{Name: "replicasets", Field: "replicasets", Namespaced: true},
+	{Name: "jobs", Field: "jobs", Namespaced: true},
+	{Name: "cronjobs", Field: "cronjobs", Namespaced: true},
+	{Name: "endpoints", Field: "endpoints", Namespaced: true},
+	{Name: "persistentvolumeclaims", Field: "persistentvolumeclaims", Namespaced: true},
+	{Name: "storageclasses", Field: "storageclasses"},
+	//Config maps may hold configuration secrets, so their data is not collected
+	{Name: "configmaps", Field: "configmaps", Namespaced: true, MetadataOnly: true},
+	{Name: "events", Field: "events", Namespaced: true},
+}
+
+// Command is the kubectl command that collects the resource.
+func (this *Resource) Command() string {
+	selector := ""
+	if this.Selector != "" {
+		selector = " --field-selector " + this.Selector
+	}
+	if this.Namespaced {
+		return "get " + this.Name + " -A" + selector + " -o json"
+	}
+	return "get " + this.Name + selector + " -o json"
+}
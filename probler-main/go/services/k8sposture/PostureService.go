@@ -0,0 +1,141 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8sposture
+
+import (
+	"time"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	ServiceName      = "K8sPosture"
+	ServiceArea      = byte(1)
+	SCAN_INTERVAL    = time.Minute * 15
+	MAX_TREND_POINTS = 672 // A week of scans
+)
+
+// PostureService scans the collected objects of every cluster in the k8s cache against the posture
+// rules. The clusters are scanned periodically to trend the findings, and on demand for a report.
+type PostureService struct {
+	vnic    ifs.IVNic
+	trend   *Trend
+	running bool
+}
+
+func Activate(vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&PostureService{}, ServiceName, ServiceArea, false, nil)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ServiceName, ": ", err.Error())
+	}
+}
+
+func (this *PostureService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	this.vnic = vnic
+	this.trend = NewTrend(MAX_TREND_POINTS)
+	this.running = true
+	vnic.Resources().Registry().RegisterEnums(types.K8SPostureRule_value)
+	vnic.Resources().Registry().Register(&types.K8SPostureQuery{})
+	vnic.Resources().Registry().Register(&types.K8SPostureReportList{})
+	vnic.Resources().Registry().Register(&types.K8SPostureReport{})
+	vnic.Resources().Registry().Register(&types.K8SPostureFinding{})
+	vnic.Resources().Registry().Register(&types.K8SPostureTrendPoint{})
+	go this.scan()
+	return nil
+}
+
+func (this *PostureService) DeActivate() error {
+	this.running = false
+	return nil
+}
+
+func (this *PostureService) scan() {
+	for this.running {
+		time.Sleep(SCAN_INTERVAL)
+		clusters, err := common.K8sClusters(this.vnic)
+		if err != nil {
+			this.vnic.Resources().Logger().Error(ServiceName, " scan failed: ", err.Error())
+			continue
+		}
+		now := time.Now().Unix()
+		for _, cluster := range clusters {
+			//Nothing to trend before the objects of the cluster are collected
+			if cluster.Objects != nil {
+				this.trend.Add(Scan(cluster, now))
+			}
+		}
+	}
+}
+
+func (this *PostureService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Post is not supported by " + ServiceName)
+}
+
+func (this *PostureService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Put is not supported by " + ServiceName)
+}
+
+func (this *PostureService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ServiceName)
+}
+
+func (this *PostureService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Delete is not supported by " + ServiceName)
+}
+
+// Get scans the queried cluster, or all the clusters, and returns their reports with their trends.
+func (this *PostureService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, ok := pb.Element().(*types.K8SPostureQuery)
+	if !ok {
+		query = &types.K8SPostureQuery{}
+	}
+	clusters, err := common.K8sClusters(this.vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	now := time.Now().Unix()
+	list := &types.K8SPostureReportList{}
+	for _, cluster := range clusters {
+		if query.Cluster != "" && query.Cluster != cluster.Name {
+			continue
+		}
+		report := Scan(cluster, now)
+		report.Trend = this.trend.Points(cluster.Name)
+		if query.Namespace != "" {
+			report = Filter(report, query.Namespace)
+		}
+		list.List = append(list.List, report)
+	}
+	return object.New(nil, list)
+}
+
+func (this *PostureService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *PostureService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *PostureService) WebService() ifs.IWebService {
+	return web.New(ServiceName, ServiceArea, nil, nil, nil, nil, nil, nil, nil, nil,
+		&types.K8SPostureQuery{}, &types.K8SPostureReportList{})
+}
@@ -0,0 +1,258 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8sposture
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/saichler/probler/go/types"
+)
+
+// The label of the default roles created by the api server, they are not scanned.
+const BOOTSTRAPPING_LABEL = "kubernetes.io/bootstrapping"
+
+// The capabilities that make a container as good as privileged.
+var privilegedCapabilities = map[string]bool{"ALL": true, "SYS_ADMIN": true, "NET_ADMIN": true, "SYS_PTRACE": true,
+	"SYS_MODULE": true}
+
+// Scan evaluates the collected objects of a cluster against the posture rules. The pod templates of the
+// workloads are scanned rather than their pods, so a finding is reported once per workload, and only
+// pods without a controller are scanned directly.
+func Scan(cluster *types.K8SCluster, now int64) *types.K8SPostureReport {
+	report := &types.K8SPostureReport{Cluster: cluster.Name, Scanned: now, NamespaceCounts: make(map[string]int32)}
+	objects := cluster.Objects
+	if objects == nil {
+		return report
+	}
+	for _, d := range objects.Deployments {
+		if d.Spec != nil {
+			scanTemplate(report, d.Metadata, "Deployment", d.Spec.Template)
+		}
+	}
+	for _, s := range objects.Statefulsets {
+		if s.Spec != nil {
+			scanTemplate(report, s.Metadata, "StatefulSet", s.Spec.Template)
+		}
+	}
+	for _, d := range objects.Daemonsets {
+		if d.Spec != nil {
+			scanTemplate(report, d.Metadata, "DaemonSet", d.Spec.Template)
+		}
+	}
+	for _, c := range objects.Cronjobs {
+		if c.Spec != nil && c.Spec.JobTemplate != nil && c.Spec.JobTemplate.Spec != nil {
+			scanTemplate(report, c.Metadata, "CronJob", c.Spec.JobTemplate.Spec.Template)
+		}
+	}
+	for _, j := range objects.Jobs {
+		if j.Spec != nil && !controlled(j.Metadata) {
+			scanTemplate(report, j.Metadata, "Job", j.Spec.Template)
+		}
+	}
+	for _, p := range objects.Pods {
+		if !controlled(p.Metadata) {
+			scanPod(report, p.Metadata, "Pod", p.Spec)
+		}
+	}
+	for _, r := range objects.Clusterroles {
+		scanRules(report, r.Metadata, "ClusterRole", r.Rules)
+	}
+	for _, r := range objects.Roles {
+		scanRules(report, r.Metadata, "Role", r.Rules)
+	}
+	scanNetworkPolicies(report, objects)
+	sort.Slice(report.Findings, func(i, j int) bool {
+		a, b := report.Findings[i], report.Findings[j]
+		if a.Severity != b.Severity {
+			return a.Severity > b.Severity
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Rule < b.Rule
+	})
+	return report
+}
+
+func scanTemplate(report *types.K8SPostureReport, metadata *types.ObjectMeta, kind string, template *types.PodTemplateSpec) {
+	if template != nil {
+		scanPod(report, metadata, kind, template.Spec)
+	}
+}
+
+func scanPod(report *types.K8SPostureReport, metadata *types.ObjectMeta, kind string, spec *types.PodSpec) {
+	if spec == nil || metadata == nil {
+		return
+	}
+	namespaces := make([]string, 0, 3)
+	if spec.HostNetwork {
+		namespaces = append(namespaces, "network")
+	}
+	if spec.HostPid {
+		namespaces = append(namespaces, "pid")
+	}
+	if spec.HostIpc {
+		namespaces = append(namespaces, "ipc")
+	}
+	if len(namespaces) > 0 {
+		add(report, types.K8SPostureRule_HostNamespace, types.K8SProblemSeverity_ProblemCritical, metadata, kind, "",
+			"Shares the host "+strings.Join(namespaces, ", ")+" namespace")
+	}
+	for _, volume := range spec.Volumes {
+		if volume.HostPath != nil {
+			add(report, types.K8SPostureRule_HostPathVolume, types.K8SProblemSeverity_ProblemWarning, metadata, kind, "",
+				"Volume "+volume.Name+" mounts the host path "+volume.HostPath.Path)
+		}
+	}
+	containers := append(append([]*types.Container{}, spec.InitContainers...), spec.Containers...)
+	for _, container := range containers {
+		scanContainer(report, metadata, kind, spec.SecurityContext, container)
+	}
+}
+
+func scanContainer(report *types.K8SPostureReport, metadata *types.ObjectMeta, kind string,
+	podContext *types.SecurityContext, container *types.Container) {
+	context := container.SecurityContext
+	if context.GetPrivileged() {
+		add(report, types.K8SPostureRule_PrivilegedContainer, types.K8SProblemSeverity_ProblemCritical, metadata, kind,
+			container.Name, "Runs privileged")
+	}
+	for _, capability := range context.GetCapabilities().GetAdd() {
+		if privilegedCapabilities[strings.TrimPrefix(strings.ToUpper(capability), "CAP_")] {
+			add(report, types.K8SPostureRule_PrivilegedContainer, types.K8SProblemSeverity_ProblemCritical, metadata, kind,
+				container.Name, "Adds the "+capability+" capability")
+		}
+	}
+	//The container context overrides the pod context, and without runAsNonRoot the user
+	//is either root or whatever the image declares
+	if !context.GetRunAsNonRoot() && !podContext.GetRunAsNonRoot() {
+		user := podContext.GetRunAsUser()
+		if context.GetRunAsUser() != 0 {
+			user = context.GetRunAsUser()
+		}
+		if user == 0 {
+			add(report, types.K8SPostureRule_RunAsRoot, types.K8SProblemSeverity_ProblemWarning, metadata, kind,
+				container.Name, "May run as root, runAsNonRoot is not set")
+		}
+	}
+	for _, env := range container.Env {
+		ref := env.GetValueFrom().GetSecretKeyRef()
+		if ref != nil {
+			add(report, types.K8SPostureRule_SecretInEnv, types.K8SProblemSeverity_ProblemWarning, metadata, kind,
+				container.Name, "Env "+env.Name+" holds the secret "+ref.Name)
+		}
+	}
+	for _, env := range container.EnvFrom {
+		if env.SecretRef != nil {
+			add(report, types.K8SPostureRule_SecretInEnv, types.K8SProblemSeverity_ProblemWarning, metadata, kind,
+				container.Name, "Env holds all the keys of the secret "+env.SecretRef.Name)
+		}
+	}
+}
+
+func scanRules(report *types.K8SPostureReport, metadata *types.ObjectMeta, kind string, rules []*types.PolicyRule) {
+	if metadata == nil || metadata.Labels[BOOTSTRAPPING_LABEL] != "" {
+		return
+	}
+	for _, rule := range rules {
+		verbs := contains(rule.Verbs, "*")
+		resources := contains(rule.Resources, "*")
+		if verbs && resources {
+			add(report, types.K8SPostureRule_WildcardRole, types.K8SProblemSeverity_ProblemCritical, metadata, kind, "",
+				"Grants all the verbs on all the resources of the api groups "+strings.Join(rule.ApiGroups, ","))
+		} else if verbs {
+			add(report, types.K8SPostureRule_WildcardRole, types.K8SProblemSeverity_ProblemWarning, metadata, kind, "",
+				"Grants all the verbs on "+strings.Join(rule.Resources, ","))
+		} else if resources {
+			add(report, types.K8SPostureRule_WildcardRole, types.K8SProblemSeverity_ProblemWarning, metadata, kind, "",
+				"Grants "+strings.Join(rule.Verbs, ",")+" on all the resources")
+		}
+	}
+}
+
+// scanNetworkPolicies reports the namespaces no network policy applies to. The kube- namespaces are
+// managed by the cluster distribution and are skipped.
+func scanNetworkPolicies(report *types.K8SPostureReport, objects *types.K8SObjects) {
+	covered := make(map[string]bool)
+	for _, policy := range objects.Networkpolicies {
+		if policy.Metadata != nil {
+			covered[policy.Metadata.Namespace] = true
+		}
+	}
+	for _, namespace := range objects.Namespaces {
+		if namespace.Metadata == nil || strings.HasPrefix(namespace.Metadata.Name, "kube-") {
+			continue
+		}
+		if !covered[namespace.Metadata.Name] {
+			metadata := &types.ObjectMeta{Name: namespace.Metadata.Name, Namespace: namespace.Metadata.Name}
+			add(report, types.K8SPostureRule_NamespaceWithoutNetworkPolicy, types.K8SProblemSeverity_ProblemWarning,
+				metadata, "Namespace", "", "No network policy, all the pods accept any traffic")
+		}
+	}
+}
+
+func add(report *types.K8SPostureReport, rule types.K8SPostureRule, severity types.K8SProblemSeverity,
+	metadata *types.ObjectMeta, kind, container, detail string) {
+	report.Findings = append(report.Findings, &types.K8SPostureFinding{Rule: rule, Severity: severity,
+		Namespace: metadata.Namespace, ObjectKind: kind, Name: metadata.Name, Container: container, Detail: detail})
+	report.NamespaceCounts[metadata.Namespace]++
+	switch severity {
+	case types.K8SProblemSeverity_ProblemCritical:
+		report.CriticalCount++
+	case types.K8SProblemSeverity_ProblemWarning:
+		report.WarningCount++
+	default:
+		report.InfoCount++
+	}
+}
+
+func controlled(metadata *types.ObjectMeta) bool {
+	if metadata == nil {
+		return false
+	}
+	for _, owner := range metadata.OwnerReferences {
+		if owner.Controller {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter returns a copy of the report with only the findings of the namespace.
+func Filter(report *types.K8SPostureReport, namespace string) *types.K8SPostureReport {
+	result := &types.K8SPostureReport{Cluster: report.Cluster, Scanned: report.Scanned, Trend: report.Trend,
+		NamespaceCounts: make(map[string]int32)}
+	for _, finding := range report.Findings {
+		if finding.Namespace == namespace {
+			add(result, finding.Rule, finding.Severity, &types.ObjectMeta{Namespace: finding.Namespace, Name: finding.Name},
+				finding.ObjectKind, finding.Container, finding.Detail)
+		}
+	}
+	return result
+}
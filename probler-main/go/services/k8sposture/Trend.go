@@ -0,0 +1,59 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package k8sposture
+
+import (
+	"sync"
+
+	"github.com/saichler/probler/go/types"
+)
+
+// Trend keeps the summaries of the periodic scans of every cluster, at most max points per cluster.
+type Trend struct {
+	points map[string][]*types.K8SPostureTrendPoint
+	max    int
+	mtx    *sync.RWMutex
+}
+
+func NewTrend(max int) *Trend {
+	return &Trend{points: make(map[string][]*types.K8SPostureTrendPoint), max: max, mtx: &sync.RWMutex{}}
+}
+
+// Add adds the summary of a scan to the trend of its cluster, dropping the oldest point over the bound.
+func (this *Trend) Add(report *types.K8SPostureReport) {
+	point := &types.K8SPostureTrendPoint{Time: report.Scanned, CriticalCount: report.CriticalCount,
+		WarningCount: report.WarningCount, InfoCount: report.InfoCount, RuleCounts: make(map[string]int32)}
+	for _, finding := range report.Findings {
+		point.RuleCounts[finding.Rule.String()]++
+	}
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	points := append(this.points[report.Cluster], point)
+	if len(points) > this.max {
+		points = points[len(points)-this.max:]
+	}
+	this.points[report.Cluster] = points
+}
+
+// Points returns the trend of a cluster, oldest first.
+func (this *Trend) Points(cluster string) []*types.K8SPostureTrendPoint {
+	this.mtx.RLock()
+	defer this.mtx.RUnlock()
+	points := this.points[cluster]
+	result := make([]*types.K8SPostureTrendPoint, len(points))
+	copy(result, points)
+	return result
+}
@@ -0,0 +1,175 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persist
+
+import (
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	CHANGE_TABLE        = "inventory_change"
+	CHANGE_IGNORE_TABLE = "change_ignore"
+	CHANGE_RETENTION    = time.Hour * 24 * 365
+)
+
+// CreateChangeTables returns the statements creating the change log and its ignore list, seeded
+// with the default ignore list.
+func CreateChangeTables() []string {
+	seed := make([]string, len(DEFAULT_IGNORE))
+	for i, pattern := range DEFAULT_IGNORE {
+		seed[i] = "('" + pattern + "')"
+	}
+	return []string{"CREATE TABLE IF NOT EXISTS " + CHANGE_TABLE +
+		" (seq BIGSERIAL PRIMARY KEY, device TEXT NOT NULL, path TEXT NOT NULL, kind INTEGER NOT NULL," +
+		" old_value TEXT NOT NULL, new_value TEXT NOT NULL, time BIGINT NOT NULL)",
+		"CREATE INDEX IF NOT EXISTS " + CHANGE_TABLE + "_device ON " + CHANGE_TABLE + " (device, time)",
+		"CREATE INDEX IF NOT EXISTS " + CHANGE_TABLE + "_time ON " + CHANGE_TABLE + " (time)",
+		"CREATE TABLE IF NOT EXISTS " + CHANGE_IGNORE_TABLE + " (pattern TEXT PRIMARY KEY)",
+		"INSERT INTO " + CHANGE_IGNORE_TABLE + " (pattern) VALUES " + strings.Join(seed, ", ") + " ON CONFLICT DO NOTHING"}
+}
+
+// ChangeLog stores the detected changes and the ignore list.
+type ChangeLog struct {
+	db *sql.DB
+}
+
+func NewChangeLog(db *sql.DB) *ChangeLog {
+	return &ChangeLog{db: db}
+}
+
+// Add stores the changes in a single transaction, setting their sequence.
+func (this *ChangeLog) Add(changes []*types.InventoryChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	tx, err := this.db.Begin()
+	if err != nil {
+		return err
+	}
+	for _, change := range changes {
+		err = tx.QueryRow("INSERT INTO "+CHANGE_TABLE+" (device, path, kind, old_value, new_value, time) "+
+			"VALUES ($1, $2, $3, $4, $5, $6) RETURNING seq", change.Device, change.Path, int32(change.Kind),
+			change.OldValue, change.NewValue, change.Time).Scan(&change.Seq)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Query returns the queried changes in the order they were detected, the latest ones when limited.
+func (this *ChangeLog) Query(query *types.InventoryChangeQuery) ([]*types.InventoryChange, error) {
+	until := query.Until
+	if until == 0 {
+		until = time.Now().Unix()
+	}
+	where := []string{"time >= $1", "time <= $2"}
+	args := []interface{}{query.Since, until}
+	if query.Device != "" {
+		args = append(args, query.Device)
+		where = append(where, "device = $"+strconv.Itoa(len(args)))
+	}
+	if query.Path != "" {
+		args = append(args, Like(query.Path))
+		where = append(where, "path LIKE $"+strconv.Itoa(len(args)))
+	}
+	text := "SELECT seq, device, path, kind, old_value, new_value, time FROM " + CHANGE_TABLE +
+		" WHERE " + strings.Join(where, " AND ") + " ORDER BY seq DESC"
+	if query.Limit > 0 {
+		text += " LIMIT " + strconv.Itoa(int(query.Limit))
+	}
+	rows, err := this.db.Query(text, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := make([]*types.InventoryChange, 0)
+	for rows.Next() {
+		change := &types.InventoryChange{}
+		var kind int32
+		err = rows.Scan(&change.Seq, &change.Device, &change.Path, &kind, &change.OldValue, &change.NewValue, &change.Time)
+		if err != nil {
+			return nil, err
+		}
+		change.Kind = types.InventoryChangeKind(kind)
+		result = append(result, change)
+	}
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result, rows.Err()
+}
+
+// Like converts a glob to a sql like pattern.
+func Like(glob string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`, "*", "%")
+	return replacer.Replace(glob)
+}
+
+// Prune deletes the changes detected before the time.
+func (this *ChangeLog) Prune(before int64) (int64, error) {
+	result, err := this.db.Exec("DELETE FROM "+CHANGE_TABLE+" WHERE time < $1", before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// Ignore returns the patterns of the ignore list.
+func (this *ChangeLog) Ignore() ([]string, error) {
+	rows, err := this.db.Query("SELECT pattern FROM " + CHANGE_IGNORE_TABLE + " ORDER BY pattern")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := make([]string, 0)
+	for rows.Next() {
+		var pattern string
+		err = rows.Scan(&pattern)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, pattern)
+	}
+	return result, rows.Err()
+}
+
+// SetIgnore replaces the ignore list with the patterns.
+func (this *ChangeLog) SetIgnore(patterns []string) error {
+	tx, err := this.db.Begin()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("DELETE FROM " + CHANGE_IGNORE_TABLE)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	for _, pattern := range patterns {
+		_, err = tx.Exec("INSERT INTO "+CHANGE_IGNORE_TABLE+" (pattern) VALUES ($1) ON CONFLICT DO NOTHING", pattern)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,189 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persist
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	ChangeServiceName = "NChanges"
+	ChangeServiceArea = byte(0)
+)
+
+// ChangeService is the audit log of the network devices, it detects the field changes of every
+// device saved from the network device cache and serves them to the change management. The ignore
+// list is replaced with a Put, extended with a Post and reduced with a Delete of a ChangeIgnoreList.
+type ChangeService struct {
+	log     *ChangeLog
+	ignore  *IgnoreList
+	mtx     *sync.RWMutex
+	vnic    ifs.IVNic
+	running bool
+}
+
+func ActivateChanges(db *sql.DB, vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&ChangeService{}, ChangeServiceName, ChangeServiceArea, false, nil)
+	sla.SetArgs(db)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ChangeServiceName, ": ", err.Error())
+	}
+}
+
+func (this *ChangeService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	this.log = NewChangeLog(sla.Args()[0].(*sql.DB))
+	this.mtx = &sync.RWMutex{}
+	this.vnic = vnic
+	patterns, err := this.log.Ignore()
+	if err != nil {
+		return err
+	}
+	this.ignore = NewIgnoreList(patterns)
+	vnic.Resources().Registry().RegisterEnums(types.InventoryChangeKind_value)
+	vnic.Resources().Registry().Register(&types.InventoryChangeQuery{})
+	vnic.Resources().Registry().Register(&types.InventoryChangeList{})
+	vnic.Resources().Registry().Register(&types.InventoryChange{})
+	vnic.Resources().Registry().Register(&types.ChangeIgnoreList{})
+	AddListener(common.NetworkDevice_Links_ID, this.detect)
+	this.running = true
+	go this.prune()
+	return nil
+}
+
+func (this *ChangeService) DeActivate() error {
+	this.running = false
+	return nil
+}
+
+func (this *ChangeService) detect(key string, old, new proto.Message) {
+	if !this.running {
+		return
+	}
+	this.mtx.RLock()
+	ignore := this.ignore
+	this.mtx.RUnlock()
+	err := this.log.Add(Detect(key, old, new, ignore, time.Now().Unix()))
+	if err != nil {
+		this.vnic.Resources().Logger().Error(ChangeServiceName, " failed to log the changes of ", key, ": ", err.Error())
+	}
+}
+
+func (this *ChangeService) prune() {
+	for this.running {
+		_, err := this.log.Prune(time.Now().Add(-CHANGE_RETENTION).Unix())
+		if err != nil {
+			this.vnic.Resources().Logger().Error(ChangeServiceName, " failed to prune the changes: ", err.Error())
+		}
+		time.Sleep(HISTORY_PRUNE_INTERVAL)
+	}
+}
+
+// Post adds the patterns to the ignore list.
+func (this *ChangeService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.updateIgnore(pb, func(current map[string]bool, patterns []string) {
+		for _, pattern := range patterns {
+			current[pattern] = true
+		}
+	})
+}
+
+// Put replaces the ignore list.
+func (this *ChangeService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.updateIgnore(pb, func(current map[string]bool, patterns []string) {
+		clear(current)
+		for _, pattern := range patterns {
+			current[pattern] = true
+		}
+	})
+}
+
+func (this *ChangeService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ChangeServiceName)
+}
+
+// Delete removes the patterns from the ignore list.
+func (this *ChangeService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.updateIgnore(pb, func(current map[string]bool, patterns []string) {
+		for _, pattern := range patterns {
+			delete(current, pattern)
+		}
+	})
+}
+
+func (this *ChangeService) updateIgnore(pb ifs.IElements, update func(map[string]bool, []string)) ifs.IElements {
+	list, ok := pb.Element().(*types.ChangeIgnoreList)
+	if !ok {
+		return object.NewError("Expected a change ignore list")
+	}
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	current := make(map[string]bool)
+	for _, pattern := range this.ignore.Patterns() {
+		current[pattern] = true
+	}
+	update(current, NewIgnoreList(list.Patterns).Patterns())
+	ignore := NewIgnoreList(sortedKeys(current))
+	err := this.log.SetIgnore(ignore.Patterns())
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	this.ignore = ignore
+	return object.New(nil, &types.ChangeIgnoreList{Patterns: ignore.Patterns()})
+}
+
+// Get returns the queried changes, or the ignore list.
+func (this *ChangeService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	switch query := pb.Element().(type) {
+	case *types.InventoryChangeQuery:
+		changes, err := this.log.Query(query)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		return object.New(nil, &types.InventoryChangeList{List: changes})
+	case *types.ChangeIgnoreList:
+		this.mtx.RLock()
+		defer this.mtx.RUnlock()
+		return object.New(nil, &types.ChangeIgnoreList{Patterns: this.ignore.Patterns()})
+	}
+	return object.NewError("Expected a change query or a change ignore list")
+}
+
+func (this *ChangeService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *ChangeService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *ChangeService) WebService() ifs.IWebService {
+	return web.New(ChangeServiceName, ChangeServiceArea,
+		&types.ChangeIgnoreList{}, &types.ChangeIgnoreList{},
+		&types.ChangeIgnoreList{}, &types.ChangeIgnoreList{},
+		nil, nil,
+		&types.ChangeIgnoreList{}, &types.ChangeIgnoreList{},
+		&types.InventoryChangeQuery{}, &types.InventoryChangeList{})
+}
@@ -0,0 +1,286 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persist
+
+import (
+	"bytes"
+	"encoding/csv"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DEFAULT_IGNORE are the counters and gauges that change on every poll, seeding the ignore list.
+var DEFAULT_IGNORE = []string{"*statistics*", "*performance*", "*metrics*", "*uptime", "*last_seen",
+	"*last_flap_time", "*temperature*", "*utilization_percent", "*match_packets", "*match_bytes",
+	"*total_packets", "*total_bytes"}
+
+// The fields identifying the elements of a list, in order of preference.
+var identityFields = []string{"id", "name", "key"}
+
+// IgnoreList matches the paths whose changes are not recorded, a pattern matches a path and
+// everything under it, with * matching any characters.
+type IgnoreList struct {
+	patterns []string
+	exprs    []*regexp.Regexp
+}
+
+func NewIgnoreList(patterns []string) *IgnoreList {
+	ignore := &IgnoreList{}
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		expr := strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*")
+		ignore.patterns = append(ignore.patterns, pattern)
+		ignore.exprs = append(ignore.exprs, regexp.MustCompile(`^`+expr+`($|[.\[])`))
+	}
+	return ignore
+}
+
+func (this *IgnoreList) Patterns() []string {
+	return append([]string{}, this.patterns...)
+}
+
+func (this *IgnoreList) Ignored(path string) bool {
+	for _, expr := range this.exprs {
+		if expr.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// Detect returns the changes from the old device to the new one, old is nil for a new device and
+// new is nil for a removed one. Elements of lists are matched by their id, or name, and by their
+// index when they have none.
+func Detect(device string, old, new proto.Message, ignore *IgnoreList, now int64) []*types.InventoryChange {
+	changes := make([]*types.InventoryChange, 0)
+	add := func(path string, kind types.InventoryChangeKind, oldValue, newValue string) {
+		changes = append(changes, &types.InventoryChange{Device: device, Path: path, Kind: kind,
+			OldValue: oldValue, NewValue: newValue, Time: now})
+	}
+	switch {
+	case old == nil && new == nil:
+	case old == nil:
+		add("", types.InventoryChangeKind_INVENTORY_CHANGE_ADDED, "", device)
+	case new == nil:
+		add("", types.InventoryChangeKind_INVENTORY_CHANGE_REMOVED, device, "")
+	default:
+		detect(old.ProtoReflect(), new.ProtoReflect(), "", ignore, add)
+	}
+	return changes
+}
+
+type addChange func(path string, kind types.InventoryChangeKind, oldValue, newValue string)
+
+func detect(old, new protoreflect.Message, path string, ignore *IgnoreList, add addChange) {
+	fields := new.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		fdPath := string(fd.Name())
+		if path != "" {
+			fdPath = path + "." + fdPath
+		}
+		if ignore.Ignored(fdPath) || (!old.Has(fd) && !new.Has(fd)) {
+			continue
+		}
+		switch {
+		case fd.IsMap():
+			detectMap(fd, old.Get(fd).Map(), new.Get(fd).Map(), fdPath, ignore, add)
+		case fd.IsList():
+			detectList(fd, old.Get(fd).List(), new.Get(fd).List(), fdPath, ignore, add)
+		default:
+			detectValue(fd, old.Has(fd), new.Has(fd), old.Get(fd), new.Get(fd), fdPath, ignore, add)
+		}
+	}
+}
+
+func detectMap(fd protoreflect.FieldDescriptor, old, new protoreflect.Map, path string, ignore *IgnoreList, add addChange) {
+	keys := make(map[string]protoreflect.MapKey)
+	old.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		keys[k.String()] = k
+		return true
+	})
+	new.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		keys[k.String()] = k
+		return true
+	})
+	for _, name := range sortedKeys(keys) {
+		k := keys[name]
+		detectValue(fd.MapValue(), old.Has(k), new.Has(k), old.Get(k), new.Get(k), path+"["+name+"]", ignore, add)
+	}
+}
+
+func detectList(fd protoreflect.FieldDescriptor, old, new protoreflect.List, path string, ignore *IgnoreList, add addChange) {
+	if fd.Message() == nil {
+		oldValue, newValue := listString(fd, old), listString(fd, new)
+		if oldValue != newValue {
+			add(path, changeKind(old.Len() > 0, new.Len() > 0), oldValue, newValue)
+		}
+		return
+	}
+	oldElems, oldOk := identities(old)
+	newElems, newOk := identities(new)
+	if !oldOk || !newOk {
+		oldElems, newElems = indexes(old), indexes(new)
+	}
+	keys := make(map[string]bool)
+	for key := range oldElems {
+		keys[key] = true
+	}
+	for key := range newElems {
+		keys[key] = true
+	}
+	for _, key := range sortedKeys(keys) {
+		oldElem, hasOld := oldElems[key]
+		newElem, hasNew := newElems[key]
+		detectValue(fd, hasOld, hasNew, oldElem, newElem, path+"["+key+"]", ignore, add)
+	}
+}
+
+// detectValue compares a single value, recursing into messages that exist on both sides.
+func detectValue(fd protoreflect.FieldDescriptor, hasOld, hasNew bool, old, new protoreflect.Value, path string,
+	ignore *IgnoreList, add addChange) {
+	if ignore.Ignored(path) || (!hasOld && !hasNew) {
+		return
+	}
+	if fd.Message() != nil {
+		switch {
+		case hasOld && hasNew:
+			if !old.Equal(new) {
+				detect(old.Message(), new.Message(), path, ignore, add)
+			}
+		case hasNew:
+			add(path, types.InventoryChangeKind_INVENTORY_CHANGE_ADDED, "", identity(new.Message()))
+		default:
+			add(path, types.InventoryChangeKind_INVENTORY_CHANGE_REMOVED, identity(old.Message()), "")
+		}
+		return
+	}
+	oldValue, newValue := "", ""
+	if hasOld {
+		oldValue = valueString(fd, old)
+	}
+	if hasNew {
+		newValue = valueString(fd, new)
+	}
+	if oldValue != newValue {
+		add(path, changeKind(hasOld, hasNew), oldValue, newValue)
+	}
+}
+
+func changeKind(hasOld, hasNew bool) types.InventoryChangeKind {
+	switch {
+	case hasOld && hasNew:
+		return types.InventoryChangeKind_INVENTORY_CHANGE_MODIFIED
+	case hasNew:
+		return types.InventoryChangeKind_INVENTORY_CHANGE_ADDED
+	}
+	return types.InventoryChangeKind_INVENTORY_CHANGE_REMOVED
+}
+
+func valueString(fd protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	if fd.Kind() == protoreflect.EnumKind {
+		if enum := fd.Enum().Values().ByNumber(value.Enum()); enum != nil {
+			return string(enum.Name())
+		}
+		return strconv.Itoa(int(value.Enum()))
+	}
+	return value.String()
+}
+
+func listString(fd protoreflect.FieldDescriptor, list protoreflect.List) string {
+	values := make([]string, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		values[i] = valueString(fd, list.Get(i))
+	}
+	return strings.Join(values, ",")
+}
+
+// identity returns the id, or name, of the message, or an empty string when it has none.
+func identity(msg protoreflect.Message) string {
+	for _, name := range identityFields {
+		fd := msg.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd != nil && fd.Kind() == protoreflect.StringKind && !fd.IsList() && msg.Get(fd).String() != "" {
+			return msg.Get(fd).String()
+		}
+	}
+	return ""
+}
+
+// identities returns the messages of the list by their identity, false if any has none or it repeats.
+func identities(list protoreflect.List) (map[string]protoreflect.Value, bool) {
+	result := make(map[string]protoreflect.Value, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		key := identity(list.Get(i).Message())
+		if _, ok := result[key]; ok || key == "" {
+			return nil, false
+		}
+		result[key] = list.Get(i)
+	}
+	return result, true
+}
+
+func indexes(list protoreflect.List) map[string]protoreflect.Value {
+	result := make(map[string]protoreflect.Value, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		result[strconv.Itoa(i)] = list.Get(i)
+	}
+	return result
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var changesCsvHeader = []string{"Time", "Device", "Path", "Change", "Old Value", "New Value"}
+
+// ChangesToCSV renders the change list as CSV, one row per change.
+func ChangesToCSV(list *types.InventoryChangeList) ([]byte, error) {
+	buff := &bytes.Buffer{}
+	w := csv.NewWriter(buff)
+	err := w.Write(changesCsvHeader)
+	if err != nil {
+		return nil, err
+	}
+	for _, change := range list.List {
+		err = w.Write([]string{time.Unix(change.Time, 0).UTC().Format(time.RFC3339), change.Device, change.Path,
+			ChangeKindName(change.Kind), change.OldValue, change.NewValue})
+		if err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buff.Bytes(), w.Error()
+}
+
+// ChangeKindName returns the short name of the change kind, e.g. modified.
+func ChangeKindName(kind types.InventoryChangeKind) string {
+	return strings.ToLower(strings.TrimPrefix(kind.String(), "INVENTORY_CHANGE_"))
+}
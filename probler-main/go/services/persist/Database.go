@@ -0,0 +1,183 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persist
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/saichler/l8types/go/ifs"
+)
+
+const (
+	EMBEDDED_START_SCRIPT = "/start-postgres.sh"
+	DEFAULT_DB_PORT       = "5432"
+	DB_READY_TIMEOUT      = time.Minute * 2
+	DB_STOP_TIMEOUT       = time.Second * 30
+	DB_BACKOFF_MIN        = time.Millisecond * 500
+	DB_BACKOFF_MAX        = time.Second * 10
+	// The environment of an external postgres, when PROBLER_DB_HOST is not set the embedded postgres
+	// of the orm image is started.
+	ENV_DB_HOST = "PROBLER_DB_HOST"
+	ENV_DB_PORT = "PROBLER_DB_PORT"
+	// Set to true to let the model migration drop columns and change their type.
+	ENV_DB_APPROVE_DESTRUCTIVE = "PROBLER_DB_APPROVE_DESTRUCTIVE"
+)
+
+// Database is the postgres of the orm, either an external one or the one embedded in the orm image.
+type Database struct {
+	DB       *sql.DB
+	embedded bool
+	log      ifs.ILogger
+}
+
+// Start starts the embedded postgres unless an external one is configured, connects to it, waits
+// until it is ready and migrates its schema to the latest version and to the model of the persisted types.
+func Start(credsKey, dbName string, resources ifs.IResources) (*Database, error) {
+	_, user, pass, _, err := resources.Security().Credential(credsKey, dbName, resources)
+	if err != nil {
+		return nil, errors.New(credsKey + " " + err.Error())
+	}
+	database := &Database{log: resources.Logger()}
+	host, port := os.Getenv(ENV_DB_HOST), os.Getenv(ENV_DB_PORT)
+	if port == "" {
+		port = DEFAULT_DB_PORT
+	}
+	if host == "" {
+		host = "127.0.0.1"
+		database.embedded = true
+		out, err := exec.Command(EMBEDDED_START_SCRIPT, dbName, user, pass).CombinedOutput()
+		if err != nil {
+			return nil, errors.New("Failed to start the embedded postgres: " + err.Error() + " " + string(out))
+		}
+	}
+	database.DB, err = sql.Open("postgres", fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		host, port, user, pass, dbName))
+	if err != nil {
+		return nil, err
+	}
+	err = database.waitReady(DB_READY_TIMEOUT)
+	if err != nil {
+		database.DB.Close()
+		return nil, err
+	}
+	version, err := Migrate(database.DB, Migrations)
+	if err != nil {
+		database.DB.Close()
+		return nil, err
+	}
+	version, err = database.evolve(os.Getenv(ENV_DB_APPROVE_DESTRUCTIVE) == "true")
+	if err != nil {
+		database.DB.Close()
+		return nil, err
+	}
+	database.log.Info("Database ", dbName, " at ", host, ":", port, " is ready, schema version ", version)
+	return database, nil
+}
+
+// evolve migrates the tables to the model of the persisted types, destructive changes are only
+// applied when approved and are otherwise left for an operator to review with "prctl schema plan".
+func (this *Database) evolve(approveDestructive bool) (int, error) {
+	plan, err := Plan(this.DB, nil)
+	if err != nil {
+		return 0, err
+	}
+	if !approveDestructive {
+		for _, change := range plan.Changes {
+			if change.Destructive {
+				this.log.Warning("Refusing destructive schema change, set ", ENV_DB_APPROVE_DESTRUCTIVE,
+					"=true to apply: ", change.Statement)
+			}
+		}
+	}
+	return ApplyPlan(this.DB, plan, approveDestructive)
+}
+
+// waitReady pings the database with an exponential backoff until it answers or the timeout passes.
+func (this *Database) waitReady(timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for attempt := 0; ; attempt++ {
+		err := this.DB.Ping()
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return errors.New("Database is not ready: " + err.Error())
+		}
+		this.log.Info("Waiting for the database: ", err.Error())
+		time.Sleep(Backoff(attempt))
+	}
+}
+
+// Backoff returns the delay before the next attempt, doubling from DB_BACKOFF_MIN up to DB_BACKOFF_MAX.
+func Backoff(attempt int) time.Duration {
+	delay := DB_BACKOFF_MIN
+	for i := 0; i < attempt && delay < DB_BACKOFF_MAX; i++ {
+		delay *= 2
+	}
+	if delay > DB_BACKOFF_MAX {
+		return DB_BACKOFF_MAX
+	}
+	return delay
+}
+
+// Stop closes the connections, waiting for the running statements, and stops the embedded postgres
+// with a fast shutdown, so it does not need a recovery on the next start.
+func (this *Database) Stop() {
+	err := this.DB.Close()
+	if err != nil {
+		this.log.Error("Failed to close the database: ", err.Error())
+	}
+	if !this.embedded {
+		return
+	}
+	dataDir := os.Getenv("PGDATA")
+	if dataDir == "" {
+		this.log.Info("PGDATA is not set, the embedded postgres stops with the container")
+		return
+	}
+	//The first line of the pid file is the postmaster pid, SIGINT is its fast shutdown
+	data, err := os.ReadFile(filepath.Join(dataDir, "postmaster.pid"))
+	if err != nil {
+		this.log.Error("Failed to stop the embedded postgres: ", err.Error())
+		return
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(strings.SplitN(string(data), "\n", 2)[0]))
+	if err == nil {
+		err = syscall.Kill(pid, syscall.SIGINT)
+	}
+	if err != nil {
+		this.log.Error("Failed to stop the embedded postgres: ", err.Error())
+		return
+	}
+	deadline := time.Now().Add(DB_STOP_TIMEOUT)
+	for syscall.Kill(pid, 0) == nil {
+		if time.Now().After(deadline) {
+			this.log.Error("The embedded postgres did not stop in ", DB_STOP_TIMEOUT)
+			return
+		}
+		time.Sleep(DB_BACKOFF_MIN)
+	}
+	this.log.Info("Stopped the embedded postgres")
+}
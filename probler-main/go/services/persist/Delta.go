@@ -0,0 +1,176 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persist
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Delta returns the field level changes from the old element to the new one, or nil when nothing
+// changed. Without an old element the delta is a snapshot of the new one.
+func Delta(key string, old, new proto.Message, now int64) (*types.InventoryDelta, error) {
+	delta := &types.InventoryDelta{Key: key, Time: now}
+	if old == nil {
+		delta.Snapshot = true
+		data, err := proto.Marshal(new)
+		if err != nil {
+			return nil, err
+		}
+		delta.Patch = data
+		return delta, nil
+	}
+	patch := new.ProtoReflect().New()
+	diff(old.ProtoReflect(), new.ProtoReflect(), patch, nil, delta)
+	if len(delta.Fields) == 0 {
+		return nil, nil
+	}
+	data, err := proto.Marshal(patch.Interface())
+	if err != nil {
+		return nil, err
+	}
+	delta.Patch = data
+	return delta, nil
+}
+
+// diff sets the changed fields of the new message in the patch, lists are compared and replaced as
+// a whole while maps are compared per entry.
+func diff(old, new, patch protoreflect.Message, path []string, delta *types.InventoryDelta) {
+	fields := new.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		hasOld, hasNew := old.Has(fd), new.Has(fd)
+		if !hasOld && !hasNew {
+			continue
+		}
+		fdPath := append(append([]string{}, path...), string(fd.Name()))
+		if !hasNew {
+			delta.Cleared = append(delta.Cleared, &types.FieldPath{Fields: fdPath})
+			delta.Fields = append(delta.Fields, strings.Join(fdPath, "."))
+			continue
+		}
+		switch {
+		case fd.IsMap():
+			oldMap, newMap := old.Get(fd).Map(), new.Get(fd).Map()
+			newMap.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				if !oldMap.Has(k) || !oldMap.Get(k).Equal(v) {
+					patch.Mutable(fd).Map().Set(k, v)
+					delta.Fields = append(delta.Fields, strings.Join(fdPath, ".")+"["+k.String()+"]")
+				}
+				return true
+			})
+			oldMap.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				if !newMap.Has(k) {
+					delta.Cleared = append(delta.Cleared, &types.FieldPath{Fields: fdPath, MapKey: k.String(), HasMapKey: true})
+					delta.Fields = append(delta.Fields, strings.Join(fdPath, ".")+"["+k.String()+"]")
+				}
+				return true
+			})
+		case fd.Message() != nil && !fd.IsList() && hasOld:
+			if !old.Get(fd).Equal(new.Get(fd)) {
+				diff(old.Get(fd).Message(), new.Get(fd).Message(), patch.Mutable(fd).Message(), fdPath, delta)
+			}
+		default:
+			if !hasOld || !old.Get(fd).Equal(new.Get(fd)) {
+				patch.Set(fd, new.Get(fd))
+				delta.Fields = append(delta.Fields, strings.Join(fdPath, "."))
+			}
+		}
+	}
+}
+
+// Apply replays the delta on the element, which must be the element as it was before the delta.
+func Apply(elem proto.Message, delta *types.InventoryDelta) error {
+	if delta.Snapshot {
+		return proto.Unmarshal(delta.Patch, elem)
+	}
+	patch := elem.ProtoReflect().New()
+	err := proto.Unmarshal(delta.Patch, patch.Interface())
+	if err != nil {
+		return err
+	}
+	merge(elem.ProtoReflect(), patch)
+	for _, cleared := range delta.Cleared {
+		clearPath(elem.ProtoReflect(), cleared)
+	}
+	return nil
+}
+
+func merge(elem, patch protoreflect.Message) {
+	patch.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			entries := elem.Mutable(fd).Map()
+			v.Map().Range(func(k protoreflect.MapKey, value protoreflect.Value) bool {
+				entries.Set(k, value)
+				return true
+			})
+		case fd.Message() != nil && !fd.IsList() && elem.Has(fd):
+			merge(elem.Mutable(fd).Message(), v.Message())
+		default:
+			elem.Set(fd, v)
+		}
+		return true
+	})
+}
+
+func clearPath(elem protoreflect.Message, path *types.FieldPath) {
+	for i, name := range path.Fields {
+		fd := elem.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil || !elem.Has(fd) {
+			return
+		}
+		if i < len(path.Fields)-1 {
+			elem = elem.Mutable(fd).Message()
+			continue
+		}
+		if !path.HasMapKey {
+			elem.Clear(fd)
+			return
+		}
+		key, ok := mapKey(fd.MapKey(), path.MapKey)
+		if ok {
+			elem.Mutable(fd).Map().Clear(key)
+		}
+	}
+}
+
+// mapKey parses the map key from its string form.
+func mapKey(fd protoreflect.FieldDescriptor, value string) (protoreflect.MapKey, bool) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(value).MapKey(), true
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(value == "true").MapKey(), true
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		i, err := strconv.ParseInt(value, 10, 32)
+		return protoreflect.ValueOfInt32(int32(i)).MapKey(), err == nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		i, err := strconv.ParseInt(value, 10, 64)
+		return protoreflect.ValueOfInt64(i).MapKey(), err == nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		i, err := strconv.ParseUint(value, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(i)).MapKey(), err == nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		i, err := strconv.ParseUint(value, 10, 64)
+		return protoreflect.ValueOfUint64(i).MapKey(), err == nil
+	}
+	return protoreflect.MapKey{}, false
+}
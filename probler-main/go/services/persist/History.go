@@ -0,0 +1,216 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persist
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// A snapshot is stored every SNAPSHOT_INTERVAL deltas of an element, bounding the deltas replayed
+	// to reconstruct it.
+	SNAPSHOT_INTERVAL      = 100
+	HISTORY_RETENTION      = time.Hour * 24 * 30
+	HISTORY_PRUNE_INTERVAL = time.Hour
+)
+
+// History stores the deltas of the elements of a type per poll, in a table named after the type,
+// e.g. networkdevice_history, ordered by their sequence.
+type History struct {
+	db     *sql.DB
+	name   string
+	sample proto.Message
+}
+
+// NewHistory returns the history of the sample's type, the table is created by the schema migrations.
+func NewHistory(db *sql.DB, sample proto.Message) (*History, error) {
+	history := &History{db: db, name: HistoryTableName(sample), sample: sample}
+	_, err := db.Exec("SELECT 1 FROM " + history.name + " LIMIT 0")
+	if err != nil {
+		return nil, errors.New("No table for " + history.name + ", is it missing a migration? " + err.Error())
+	}
+	return history, nil
+}
+
+// HistoryTableName returns the history table name of the element type.
+func HistoryTableName(sample proto.Message) string {
+	return TableName(sample) + "_history"
+}
+
+// CreateHistoryTable returns the statements creating the history table of the element type.
+func CreateHistoryTable(sample proto.Message) []string {
+	name := HistoryTableName(sample)
+	return []string{"CREATE TABLE IF NOT EXISTS " + name +
+		" (seq BIGSERIAL PRIMARY KEY, key TEXT NOT NULL, time BIGINT NOT NULL, snapshot BOOLEAN NOT NULL, data BYTEA NOT NULL)",
+		"CREATE INDEX IF NOT EXISTS " + name + "_key ON " + name + " (key, time)"}
+}
+
+// Record stores the delta from the old element to the new one, if anything changed.
+func (this *History) Record(key string, old, new proto.Message, now int64) error {
+	delta, err := Delta(key, old, new, now)
+	if err != nil || delta == nil {
+		return err
+	}
+	if !delta.Snapshot {
+		var count int
+		err = this.db.QueryRow("SELECT COUNT(*) FROM "+this.name+" WHERE key = $1 AND seq > "+
+			"COALESCE((SELECT MAX(seq) FROM "+this.name+" WHERE key = $1 AND snapshot), 0)", key).Scan(&count)
+		if err != nil {
+			return err
+		}
+		if count >= SNAPSHOT_INTERVAL {
+			//Keep the changed fields for the change log, the patch is now the whole element
+			delta.Snapshot = true
+			delta.Cleared = nil
+			delta.Patch, err = proto.Marshal(new)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return this.insert(delta)
+}
+
+// RecordDelete stores the deletion of the element, the element does not exist from this time on.
+func (this *History) RecordDelete(key string, now int64) error {
+	return this.insert(&types.InventoryDelta{Key: key, Time: now, Snapshot: true, Deleted: true})
+}
+
+func (this *History) insert(delta *types.InventoryDelta) error {
+	data, err := proto.Marshal(delta)
+	if err != nil {
+		return err
+	}
+	_, err = this.db.Exec("INSERT INTO "+this.name+" (key, time, snapshot, data) VALUES ($1, $2, $3, $4)",
+		delta.Key, delta.Time, delta.Snapshot, data)
+	return err
+}
+
+// Deltas returns the deltas of the element between the times, in order. A 0 until is now.
+func (this *History) Deltas(key string, since, until int64) ([]*types.InventoryDelta, error) {
+	if until == 0 {
+		until = time.Now().Unix()
+	}
+	return this.query("SELECT data FROM "+this.name+" WHERE key = $1 AND time >= $2 AND time <= $3 ORDER BY seq",
+		key, since, until)
+}
+
+// AsOf reconstructs the element as it was at the time, by replaying its deltas from the last snapshot
+// before it. It returns nil if the element did not exist at the time.
+func (this *History) AsOf(key string, asOf int64) (proto.Message, error) {
+	deltas, err := this.query("SELECT data FROM "+this.name+" WHERE key = $1 AND time <= $2 AND seq >= "+
+		"COALESCE((SELECT MAX(seq) FROM "+this.name+" WHERE key = $1 AND snapshot AND time <= $2), 0) ORDER BY seq",
+		key, asOf)
+	if err != nil {
+		return nil, err
+	}
+	return Replay(this.sample, deltas)
+}
+
+// AsOfAll reconstructs all the elements that existed at the time, ordered by their key.
+func (this *History) AsOfAll(asOf int64) ([]proto.Message, error) {
+	rows, err := this.db.Query("SELECT DISTINCT key FROM "+this.name+" WHERE time <= $1 ORDER BY key", asOf)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0)
+	for rows.Next() {
+		var key string
+		err = rows.Scan(&key)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	result := make([]proto.Message, 0, len(keys))
+	for _, key := range keys {
+		elem, err := this.AsOf(key, asOf)
+		if err != nil {
+			return nil, err
+		}
+		if elem != nil {
+			result = append(result, elem)
+		}
+	}
+	return result, nil
+}
+
+// Prune deletes the deltas before the time that are not needed to reconstruct the elements after
+// it, i.e. the deltas before the last snapshot preceding it.
+func (this *History) Prune(before int64) (int64, error) {
+	result, err := this.db.Exec("DELETE FROM "+this.name+" AS h WHERE h.seq < COALESCE((SELECT MAX(s.seq) FROM "+
+		this.name+" s WHERE s.key = h.key AND s.snapshot AND s.time <= $1), 0)", before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (this *History) query(query string, args ...interface{}) ([]*types.InventoryDelta, error) {
+	rows, err := this.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := make([]*types.InventoryDelta, 0)
+	for rows.Next() {
+		var data []byte
+		err = rows.Scan(&data)
+		if err != nil {
+			return nil, err
+		}
+		delta := &types.InventoryDelta{}
+		err = proto.Unmarshal(data, delta)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, delta)
+	}
+	return result, rows.Err()
+}
+
+// Replay reconstructs an element of the sample's type from its deltas, starting with a snapshot.
+// It returns nil if the element was deleted by the last delta or there was no snapshot to start from.
+func Replay(sample proto.Message, deltas []*types.InventoryDelta) (proto.Message, error) {
+	var elem proto.Message
+	for _, delta := range deltas {
+		if delta.Deleted {
+			elem = nil
+			continue
+		}
+		if delta.Snapshot {
+			elem = sample.ProtoReflect().New().Interface()
+		}
+		if elem == nil {
+			continue
+		}
+		err := Apply(elem, delta)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return elem, nil
+}
@@ -0,0 +1,131 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persist
+
+import (
+	"errors"
+	"time"
+
+	"github.com/saichler/l8pollaris/go/pollaris/targets"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/probler/go/prob/common"
+	"google.golang.org/protobuf/proto"
+)
+
+// LINK_INTERVAL is how often the cache of a link is synced to its persist service.
+const LINK_INTERVAL = time.Second * 15
+
+// Forwarder forwards the elements of a link's cache to its persist service. It keeps the elements it
+// forwarded last, so a sync forwards only the new and changed elements, and deletes the elements
+// removed from the cache since the last sync. An element that is only stored, e.g. not reloaded into
+// the cache yet, is never deleted.
+type Forwarder struct {
+	primaryKey string
+	send       func(action ifs.Action, elem proto.Message) error
+	forwarded  map[string]proto.Message
+	synced     map[string]bool
+}
+
+// NewForwarder returns a forwarder of the elements keyed by the primaryKey field, sending them with send.
+func NewForwarder(primaryKey string, send func(action ifs.Action, elem proto.Message) error) *Forwarder {
+	return &Forwarder{primaryKey: primaryKey, send: send, forwarded: make(map[string]proto.Message),
+		synced: make(map[string]bool)}
+}
+
+// Seed marks the elements as forwarded, e.g. the elements the persist service already stores.
+func (this *Forwarder) Seed(elems []proto.Message) {
+	for _, elem := range elems {
+		this.forwarded[Key(elem, this.primaryKey)] = elem
+	}
+}
+
+// Sync forwards the changes of the cached elements since the last sync and returns how many were
+// forwarded. An element that failed to forward is forwarded again by the next sync. An empty cache,
+// e.g. a restarted one, deletes nothing.
+func (this *Forwarder) Sync(elems []proto.Message) (int, error) {
+	var last error
+	count := 0
+	cached := make(map[string]bool, len(elems))
+	for _, elem := range elems {
+		key := Key(elem, this.primaryKey)
+		if key == "" {
+			continue
+		}
+		cached[key] = true
+		if old, ok := this.forwarded[key]; ok && proto.Equal(old, elem) {
+			continue
+		}
+		err := this.send(ifs.PUT, elem)
+		if err != nil {
+			last = errors.New("Failed to forward " + key + ": " + err.Error())
+			continue
+		}
+		this.forwarded[key] = elem
+		count++
+	}
+	if len(cached) == 0 {
+		return count, last
+	}
+	for key := range this.synced {
+		old, ok := this.forwarded[key]
+		if cached[key] || !ok {
+			continue
+		}
+		err := this.send(ifs.DELETE, old)
+		if err != nil {
+			last = errors.New("Failed to forward the delete of " + key + ": " + err.Error())
+			continue
+		}
+		delete(this.forwarded, key)
+		count++
+	}
+	for key := range this.synced {
+		if _, ok := this.forwarded[key]; !ok {
+			delete(this.synced, key)
+		}
+	}
+	for key := range cached {
+		this.synced[key] = true
+	}
+	return count, last
+}
+
+// Link reloads the stored elements of the link into its cache, see Reload, and then forwards the
+// updates of the cache to its persist service, e.g. NCache to NPersist. It runs next to the cache and
+// does not return.
+func Link(linksId string, sample proto.Message, primaryKey string, vnic ifs.IVNic) {
+	name, area := targets.Links.Persist(linksId)
+	forwarder := NewForwarder(primaryKey, func(action ifs.Action, elem proto.Message) error {
+		resp := vnic.Request("", name, area, action, elem, common.INVENTORY_REQUEST_TIMEOUT)
+		if resp == nil {
+			return errors.New("No response from " + name)
+		}
+		return resp.Error()
+	})
+	forwarder.Seed(Reload(linksId, sample, primaryKey, vnic))
+	for {
+		time.Sleep(LINK_INTERVAL)
+		elems, err := Cached(linksId, sample, primaryKey, "", vnic)
+		if err != nil {
+			vnic.Resources().Logger().Error("Failed to sync ", name, ": ", err.Error())
+			continue
+		}
+		_, err = forwarder.Sync(elems)
+		if err != nil {
+			vnic.Resources().Logger().Error(name, ": ", err.Error())
+		}
+	}
+}
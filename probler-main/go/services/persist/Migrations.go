@@ -0,0 +1,153 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persist
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// The table recording the applied migrations, the schema version is the highest applied version.
+const VERSION_TABLE = "schema_version"
+
+// Migration is a versioned schema change, its statements are applied in a single transaction.
+type Migration struct {
+	Version    int
+	Name       string
+	Statements []string
+}
+
+// Migrations are the schema changes of the persisted types, in version order. A released migration
+// must never change, a schema change is always a new version below MODEL_MIGRATION_BASE.
+var Migrations = []*Migration{
+	{Version: 1, Name: "Create the network device table", Statements: []string{CreateTable(&types.NetworkDevice{})}},
+	{Version: 2, Name: "Create the k8s cluster table", Statements: []string{CreateTable(&types.K8SCluster{})}},
+	{Version: 3, Name: "Create the asset register table", Statements: []string{CreateTable(&types.Asset{})}},
+	{Version: 4, Name: "Create the version policy table", Statements: []string{CreateTable(&types.VersionPolicy{})}},
+	{Version: 5, Name: "Create the site table", Statements: []string{CreateTable(&types.Site{})}},
+	{Version: 6, Name: "Create the rack row and rack tables", Statements: []string{CreateTable(&types.RackRow{}),
+		CreateTable(&types.Rack{})}},
+	{Version: 7, Name: "Create the sensor binding table", Statements: []string{CreateTable(&types.EnvSensorBinding{})}},
+	{Version: 8, Name: "Create the network device history table", Statements: CreateHistoryTable(&types.NetworkDevice{})},
+	{Version: 9, Name: "Create the k8s cluster history table", Statements: CreateHistoryTable(&types.K8SCluster{})},
+	{Version: 10, Name: "Create the inventory change log", Statements: CreateChangeTables()},
+	{Version: 11, Name: "Create the saved query table", Statements: []string{CreateTable(&types.SavedQuery{})}},
+	{Version: 12, Name: "Create the tag table", Statements: CreateTagTables()},
+	{Version: 13, Name: "Create the ipam annotation table", Statements: []string{CreateTable(&types.IpamAnnotation{})}},
+}
+
+// CreateTable returns the statement creating the table of the element type.
+func CreateTable(sample proto.Message) string {
+	return "CREATE TABLE IF NOT EXISTS " + TableName(sample) +
+		" (id TEXT PRIMARY KEY, data BYTEA NOT NULL, updated BIGINT NOT NULL)"
+}
+
+// Migrate applies the migrations newer than the last applied one and returns the schema version.
+func Migrate(db *sql.DB, migrations []*Migration) (int, error) {
+	err := Validate(migrations)
+	if err != nil {
+		return 0, err
+	}
+	_, err = db.Exec("CREATE TABLE IF NOT EXISTS " + VERSION_TABLE +
+		" (version INT PRIMARY KEY, name TEXT NOT NULL, applied BIGINT NOT NULL)")
+	if err != nil {
+		return 0, err
+	}
+	version, err := staticVersion(db)
+	if err != nil {
+		return 0, err
+	}
+	for _, migration := range Pending(migrations, version) {
+		err = apply(db, migration)
+		if err != nil {
+			return version, errors.New("Migration " + strconv.Itoa(migration.Version) + " failed: " + err.Error())
+		}
+	}
+	return SchemaVersion(db)
+}
+
+// SchemaVersion returns the highest applied migration version, 0 for an empty schema.
+func SchemaVersion(db *sql.DB) (int, error) {
+	var version sql.NullInt64
+	err := db.QueryRow("SELECT MAX(version) FROM " + VERSION_TABLE).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
+	return int(version.Int64), nil
+}
+
+// staticVersion returns the highest applied version of the static migrations, ignoring the model ones.
+func staticVersion(db *sql.DB) (int, error) {
+	var version sql.NullInt64
+	err := db.QueryRow("SELECT MAX(version) FROM "+VERSION_TABLE+" WHERE version < $1", MODEL_MIGRATION_BASE).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
+	return int(version.Int64), nil
+}
+
+// Validate checks the migrations are in ascending version order without duplicates, and below the
+// versions of the model migrations.
+func Validate(migrations []*Migration) error {
+	last := 0
+	for _, migration := range migrations {
+		if migration.Version <= last {
+			return errors.New("Migration " + strconv.Itoa(migration.Version) + " is out of order")
+		}
+		if migration.Version >= MODEL_MIGRATION_BASE {
+			return errors.New("Migration " + strconv.Itoa(migration.Version) + " collides with the model migrations")
+		}
+		last = migration.Version
+	}
+	return nil
+}
+
+// Pending returns the migrations newer than the version.
+func Pending(migrations []*Migration, version int) []*Migration {
+	result := make([]*Migration, 0)
+	for _, migration := range migrations {
+		if migration.Version > version {
+			result = append(result, migration)
+		}
+	}
+	return result
+}
+
+func apply(db *sql.DB, migration *Migration) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	for _, statement := range migration.Statements {
+		_, err = tx.Exec(statement)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	_, err = tx.Exec("INSERT INTO "+VERSION_TABLE+" (version, name, applied) VALUES ($1, $2, $3)",
+		migration.Version, migration.Name, time.Now().Unix())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,466 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persist
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/saichler/l8pollaris/go/pollaris/targets"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8types/go/types/l8api"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/conditions"
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// PersistService is the persist service of an inventory link, e.g. NPersist, writing the link's
+// elements to Postgres. The elements are forwarded whole by the link's cache, see Link, while a patch
+// is merged into the stored element. The changes of every save are kept in the history, serving the
+// as of queries of the link.
+type PersistService struct {
+	name       string
+	linksId    string
+	sample     proto.Message
+	list       proto.Message
+	primaryKey string
+	table      *Table
+	history    *History
+	tags       *TagStore
+	running    bool
+}
+
+var keyExpr = regexp.MustCompile(`(?i)\bwhere\s+([\w.]+)\s*=\s*'?([^\s']+)'?`)
+
+// Listener is notified of every element saved by the persist service of a link, with the element it
+// replaced. old is nil for a new element and new is nil for a deleted one.
+type Listener func(key string, old, new proto.Message)
+
+var listeners = make(map[string][]Listener)
+var listenersMtx = &sync.RWMutex{}
+
+// AddListener adds a listener of the elements saved by the persist service of the link.
+func AddListener(linksId string, listener Listener) {
+	listenersMtx.Lock()
+	defer listenersMtx.Unlock()
+	listeners[linksId] = append(listeners[linksId], listener)
+}
+
+func notify(linksId, key string, old, new proto.Message) {
+	listenersMtx.RLock()
+	defer listenersMtx.RUnlock()
+	for _, listener := range listeners[linksId] {
+		listener(key, old, new)
+	}
+}
+
+// Decorator sets the fields of an element its link's cache does not own, e.g. the user defined tags,
+// before the persist service of the link saves it.
+type Decorator func(key string, elem proto.Message)
+
+var decorators = make(map[string][]Decorator)
+var decoratorsMtx = &sync.RWMutex{}
+
+// AddDecorator adds a decorator of the elements saved by the persist service of the link.
+func AddDecorator(linksId string, decorator Decorator) {
+	decoratorsMtx.Lock()
+	defer decoratorsMtx.Unlock()
+	decorators[linksId] = append(decorators[linksId], decorator)
+}
+
+// decorate returns a decorated copy of the element, or the element when the link has no decorators.
+func decorate(linksId, key string, elem proto.Message) proto.Message {
+	decoratorsMtx.RLock()
+	defer decoratorsMtx.RUnlock()
+	if len(decorators[linksId]) == 0 {
+		return elem
+	}
+	elem = proto.Clone(elem)
+	for _, decorator := range decorators[linksId] {
+		decorator(key, elem)
+	}
+	return elem
+}
+
+// GroupResolver returns the ids of the current members of a saved query, it is set by the groups
+// service, which the persist services cannot import.
+type GroupResolver func(name string, vnic ifs.IVNic) (map[string]bool, error)
+
+var groupResolver GroupResolver
+var groupResolverMtx = &sync.RWMutex{}
+
+// SetGroupResolver sets the resolver of the group terms of the inventory queries, e.g. group=lab.
+func SetGroupResolver(resolver GroupResolver) {
+	groupResolverMtx.Lock()
+	defer groupResolverMtx.Unlock()
+	groupResolver = resolver
+}
+
+// members returns the ids of the elements that are members of all the groups.
+func members(groups []string, vnic ifs.IVNic) (map[string]bool, error) {
+	groupResolverMtx.RLock()
+	resolver := groupResolver
+	groupResolverMtx.RUnlock()
+	if resolver == nil {
+		return nil, errors.New("Unsupported group term, the groups service is not active")
+	}
+	var result map[string]bool
+	for i, group := range groups {
+		ids, err := resolver(group, vnic)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			result = make(map[string]bool, len(ids))
+			for id := range ids {
+				result[id] = true
+			}
+			continue
+		}
+		for id := range result {
+			if !ids[id] {
+				delete(result, id)
+			}
+		}
+	}
+	return result, nil
+}
+
+// Activate activates the persist service of the link, its elements of the sample type are keyed by
+// the primaryKey field and listed in the list type.
+func Activate(linksId string, sample, list proto.Message, primaryKey string, db *sql.DB, vnic ifs.IVNic) {
+	name, area := targets.Links.Persist(linksId)
+	sla := ifs.NewServiceLevelAgreement(&PersistService{}, name, area, false, nil)
+	sla.SetArgs(linksId, sample, list, primaryKey, db)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", name, ": ", err.Error())
+	}
+}
+
+func (this *PersistService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	args := sla.Args()
+	err := this.init(args[0].(string), args[1].(proto.Message), args[2].(proto.Message), args[3].(string), args[4].(*sql.DB))
+	if err != nil {
+		return err
+	}
+	this.running = true
+	go this.prune(vnic)
+	vnic.Resources().Registry().Register(this.sample)
+	vnic.Resources().Registry().Register(this.list)
+	vnic.Resources().Registry().Register(&types.InventoryHistoryQuery{})
+	vnic.Resources().Registry().Register(&types.InventoryDeltaList{})
+	vnic.Resources().Introspector().Inspect(this.sample)
+	return vnic.Resources().Introspector().Decorators().AddPrimaryKeyDecorator(this.sample, this.primaryKey)
+}
+
+// NewPersistService returns the persist service of the link over the db, without activating it.
+func NewPersistService(linksId string, sample, list proto.Message, primaryKey string, db *sql.DB) (*PersistService, error) {
+	service := &PersistService{}
+	return service, service.init(linksId, sample, list, primaryKey, db)
+}
+
+func (this *PersistService) init(linksId string, sample, list proto.Message, primaryKey string, db *sql.DB) error {
+	this.linksId = linksId
+	this.sample = sample
+	this.list = list
+	this.primaryKey = primaryKey
+	this.name, _ = targets.Links.Persist(this.linksId)
+	table, err := NewTable(db, this.sample)
+	if err != nil {
+		return err
+	}
+	this.table = table
+	history, err := NewHistory(db, this.sample)
+	if err != nil {
+		return err
+	}
+	this.history = history
+	this.tags = NewTagStore(db)
+	return nil
+}
+
+func (this *PersistService) DeActivate() error {
+	this.running = false
+	this.table = nil
+	this.history = nil
+	return nil
+}
+
+func (this *PersistService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.save(pb, vnic, false)
+}
+
+func (this *PersistService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.save(pb, vnic, false)
+}
+
+func (this *PersistService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.save(pb, vnic, true)
+}
+
+func (this *PersistService) save(pb ifs.IElements, vnic ifs.IVNic, patch bool) ifs.IElements {
+	elem, key, err := this.element(pb)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	stored, err := this.table.Load(key)
+	if err != nil {
+		return object.NewError(this.name + " failed to load " + key + ": " + err.Error())
+	}
+	merged := elem
+	if patch && stored != nil {
+		merged = proto.Clone(stored)
+		proto.Merge(merged, elem)
+	}
+	merged = decorate(this.linksId, key, merged)
+	err = this.table.Save(key, merged)
+	if err != nil {
+		return object.NewError(this.name + " failed to save " + key + ": " + err.Error())
+	}
+	err = this.history.Record(key, stored, merged, time.Now().Unix())
+	if err != nil {
+		vnic.Resources().Logger().Error(this.name, " failed to record the history of ", key, ": ", err.Error())
+	}
+	notify(this.linksId, key, stored, merged)
+	return object.New(nil, merged)
+}
+
+func (this *PersistService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	elem, key, err := this.element(pb)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	stored, err := this.table.Load(key)
+	if err != nil {
+		return object.NewError(this.name + " failed to load " + key + ": " + err.Error())
+	}
+	err = this.table.Delete(key)
+	if err != nil {
+		return object.NewError(this.name + " failed to delete " + key + ": " + err.Error())
+	}
+	err = this.history.RecordDelete(key, time.Now().Unix())
+	if err != nil {
+		vnic.Resources().Logger().Error(this.name, " failed to record the history of ", key, ": ", err.Error())
+	}
+	if stored != nil {
+		notify(this.linksId, key, stored, nil)
+	}
+	return object.New(nil, elem)
+}
+
+// Get returns the stored element with the key, or all the stored elements when the key is empty.
+// A query with an as of clause returns the elements as they were at the time, and a history query
+// returns the deltas of an element.
+func (this *PersistService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	switch query := pb.Element().(type) {
+	case *l8api.L8Query:
+		return this.getAsOf(query, vnic)
+	case *types.InventoryHistoryQuery:
+		deltas, err := this.history.Deltas(query.Key, query.Since, query.Until)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		return object.New(nil, &types.InventoryDeltaList{List: deltas})
+	}
+	var elems []proto.Message
+	elem, ok := pb.Element().(proto.Message)
+	key := ""
+	if ok && elem != nil {
+		key = Key(elem, this.primaryKey)
+	}
+	if key != "" {
+		stored, err := this.table.Load(key)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		if stored != nil {
+			elems = append(elems, stored)
+		}
+	} else {
+		var err error
+		elems, err = this.table.LoadAll()
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+	}
+	list, err := NewList(this.list, elems)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, list)
+}
+
+// getAsOf returns the elements matching the query's where clause as they were at the time of its as of
+// clause, or as stored when it has none. A where clause that cannot be applied is an error. The group
+// terms select the current members of their saved queries, also for an as of query.
+func (this *PersistService) getAsOf(query *l8api.L8Query, vnic ifs.IVNic) ifs.IElements {
+	text, asOf, err := common.SplitAsOf(query.Text)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	where, err := conditions.Where(this.sample.ProtoReflect().Descriptor(), text)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	key := QueryKey(text, this.primaryKey)
+	filters := TagFilters(text)
+	var elems []proto.Message
+	switch {
+	case asOf == 0 && key == "" && len(filters) > 0:
+		elems, err = this.loadTagged(filters)
+	case asOf == 0 && key == "":
+		elems, err = this.table.LoadAll()
+	case asOf == 0:
+		var elem proto.Message
+		elem, err = this.table.Load(key)
+		if elem != nil {
+			elems = append(elems, elem)
+		}
+	case key == "":
+		elems, err = this.history.AsOfAll(asOf)
+	default:
+		var elem proto.Message
+		elem, err = this.history.AsOf(key, asOf)
+		if elem != nil {
+			elems = append(elems, elem)
+		}
+	}
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	var ids map[string]bool
+	if groups := conditions.Groups(text); len(groups) > 0 {
+		ids, err = members(groups, vnic)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+	}
+	matched := make([]proto.Message, 0, len(elems))
+	for _, elem := range elems {
+		if ids != nil && !ids[Key(elem, this.primaryKey)] {
+			continue
+		}
+		if conditions.MatchAll(elem, where) {
+			matched = append(matched, elem)
+		}
+	}
+	list, err := NewList(this.list, matched)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, list)
+}
+
+// loadTagged loads the stored elements having all the filter tags, the tag store resolves their ids.
+// An as of query is not resolved by the tag store, as the elements may have had other tags then.
+func (this *PersistService) loadTagged(filters map[string]string) ([]proto.Message, error) {
+	sets, err := this.tags.Find(filters)
+	if err != nil {
+		return nil, err
+	}
+	elems := make([]proto.Message, 0, len(sets))
+	for _, set := range sets {
+		elem, err := this.table.Load(set.Id)
+		if err != nil {
+			return nil, err
+		}
+		if elem != nil {
+			elems = append(elems, elem)
+		}
+	}
+	return elems, nil
+}
+
+// QueryKey returns the primary key value a query selects, e.g. 10.1.1.1 of
+// "select * from NetworkDevice where Id=10.1.1.1", or an empty key.
+func QueryKey(text, primaryKey string) string {
+	match := keyExpr.FindStringSubmatch(text)
+	if match == nil || !strings.EqualFold(match[1], primaryKey) {
+		return ""
+	}
+	return match[2]
+}
+
+// prune deletes the history older than the retention, keeping what reconstructs the elements after it.
+func (this *PersistService) prune(vnic ifs.IVNic) {
+	for this.running {
+		history := this.history
+		if history != nil {
+			_, err := history.Prune(time.Now().Add(-HISTORY_RETENTION).Unix())
+			if err != nil {
+				vnic.Resources().Logger().Error(this.name, " failed to prune the history: ", err.Error())
+			}
+		}
+		time.Sleep(HISTORY_PRUNE_INTERVAL)
+	}
+}
+
+func (this *PersistService) element(pb ifs.IElements) (proto.Message, string, error) {
+	elem, ok := pb.Element().(proto.Message)
+	if !ok || elem == nil {
+		return nil, "", errors.New(this.name + " expected an element")
+	}
+	key := Key(elem, this.primaryKey)
+	if key == "" {
+		return nil, "", errors.New(this.name + " expected an element with a " + this.primaryKey)
+	}
+	return elem, key, nil
+}
+
+// Cached fetches the element with the key, or all the elements when the key is empty, from the
+// cache of the link.
+func Cached(linksId string, sample proto.Message, primaryKey, key string, vnic ifs.IVNic) ([]proto.Message, error) {
+	name, area := targets.Links.Cache(linksId)
+	query := "select * from " + string(sample.ProtoReflect().Descriptor().Name())
+	if key != "" {
+		query += " where " + primaryKey + "=" + key
+	}
+	elems, err := object.NewQuery(query, vnic.Resources())
+	if err != nil {
+		return nil, err
+	}
+	resp := vnic.Request("", name, area, ifs.GET, elems.(*object.Elements).PQuery(), common.INVENTORY_REQUEST_TIMEOUT)
+	if resp == nil {
+		return nil, errors.New("No response from " + name)
+	}
+	if resp.Error() != nil {
+		return nil, resp.Error()
+	}
+	list, ok := resp.Element().(proto.Message)
+	if !ok || list == nil {
+		return nil, errors.New("Unexpected response type from " + name)
+	}
+	return Elements(list), nil
+}
+
+func (this *PersistService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *PersistService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *PersistService) WebService() ifs.IWebService {
+	return nil
+}
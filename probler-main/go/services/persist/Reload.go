@@ -0,0 +1,93 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persist
+
+import (
+	"errors"
+	"time"
+
+	"github.com/saichler/l8pollaris/go/pollaris/targets"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/probler/go/prob/common"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	RELOAD_ATTEMPTS       = 30
+	RELOAD_RETRY_INTERVAL = time.Second * 10
+)
+
+// Reload loads the stored elements of the link from its persist service into its cache, so a
+// restarted cache holds the inventory before the next poll cycle. The persist service may not be up
+// yet, so the load is retried. Elements the cache already has are fresher and are skipped. It returns
+// the stored elements, or nil when they could not be loaded.
+func Reload(linksId string, sample proto.Message, primaryKey string, vnic ifs.IVNic) []proto.Message {
+	cacheName, cacheArea := targets.Links.Cache(linksId)
+	var elems []proto.Message
+	var err error
+	for i := 0; i < RELOAD_ATTEMPTS; i++ {
+		elems, err = Stored(linksId, sample, vnic)
+		if err == nil {
+			break
+		}
+		time.Sleep(RELOAD_RETRY_INTERVAL)
+	}
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to reload ", cacheName, ": ", err.Error())
+		return nil
+	}
+	cached, err := Cached(linksId, sample, primaryKey, "", vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to reload ", cacheName, ": ", err.Error())
+		return elems
+	}
+	exist := make(map[string]bool, len(cached))
+	for _, elem := range cached {
+		exist[Key(elem, primaryKey)] = true
+	}
+	count := 0
+	for _, elem := range elems {
+		if exist[Key(elem, primaryKey)] {
+			continue
+		}
+		resp := vnic.Request("", cacheName, cacheArea, ifs.POST, elem, common.INVENTORY_REQUEST_TIMEOUT)
+		if resp != nil && resp.Error() != nil {
+			vnic.Resources().Logger().Error("Failed to reload ", Key(elem, primaryKey), " into ", cacheName, ": ",
+				resp.Error().Error())
+			continue
+		}
+		count++
+	}
+	vnic.Resources().Logger().Info("Reloaded ", count, " elements into ", cacheName)
+	return elems
+}
+
+// Stored fetches all the stored elements of the link from its persist service.
+func Stored(linksId string, sample proto.Message, vnic ifs.IVNic) ([]proto.Message, error) {
+	name, area := targets.Links.Persist(linksId)
+	resp := vnic.Request("", name, area, ifs.GET, sample.ProtoReflect().New().Interface(), common.INVENTORY_REQUEST_TIMEOUT)
+	if resp == nil {
+		return nil, errors.New("No response from " + name)
+	}
+	if resp.Error() != nil {
+		return nil, resp.Error()
+	}
+	list, ok := resp.Element().(proto.Message)
+	if !ok || list == nil {
+		return nil, errors.New("Unexpected response type from " + name)
+	}
+	return Elements(list), nil
+}
@@ -0,0 +1,278 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persist
+
+import (
+	"database/sql"
+	"sort"
+	"strconv"
+
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// The model migrations are versioned from here, the versions below are of the static migrations.
+	MODEL_MIGRATION_BASE = 1000
+	// How deep nested messages are flattened into columns.
+	MAX_COLUMN_DEPTH        = 3
+	POSTGRES_MAX_IDENTIFIER = 63
+)
+
+// Persisted are the persisted element types, their tables are evolved with the model.
+var Persisted = []proto.Message{&types.NetworkDevice{}, &types.K8SCluster{}}
+
+// The columns every table has, they are not part of the model.
+var baseColumns = map[string]bool{"id": true, "data": true, "updated": true}
+
+// Column is a queryable column of a table, the value of a scalar field of the element or of its
+// nested messages. Repeated and map fields are only held in the data column.
+type Column struct {
+	Name string
+	Type string
+	path []protoreflect.FieldDescriptor
+}
+
+// Columns returns the model columns of the element type, by name.
+func Columns(sample proto.Message) []*Column {
+	result := make([]*Column, 0)
+	columns(sample.ProtoReflect().Descriptor(), "", nil, 0, &result)
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+func columns(md protoreflect.MessageDescriptor, prefix string, path []protoreflect.FieldDescriptor, depth int,
+	result *[]*Column) {
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsList() || fd.IsMap() {
+			continue
+		}
+		name := prefix + string(fd.Name())
+		fdPath := append(append([]protoreflect.FieldDescriptor{}, path...), fd)
+		if fd.Kind() == protoreflect.MessageKind {
+			if depth+1 < MAX_COLUMN_DEPTH {
+				columns(fd.Message(), name+"_", fdPath, depth+1, result)
+			}
+			continue
+		}
+		sqlType := columnType(fd)
+		if sqlType == "" || baseColumns[name] || len(name) > POSTGRES_MAX_IDENTIFIER {
+			continue
+		}
+		*result = append(*result, &Column{Name: name, Type: sqlType, path: fdPath})
+	}
+}
+
+// columnType returns the postgres type of a scalar field, as information_schema reports it.
+func columnType(fd protoreflect.FieldDescriptor) string {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return "boolean"
+	case protoreflect.EnumKind, protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "integer"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Int64Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed64Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "bigint"
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return "double precision"
+	case protoreflect.StringKind:
+		return "text"
+	}
+	return ""
+}
+
+// Value returns the column value of the element, nil when a message on the path is not set.
+func (this *Column) Value(elem proto.Message) interface{} {
+	msg := elem.ProtoReflect()
+	for _, fd := range this.path[:len(this.path)-1] {
+		if !msg.Has(fd) {
+			return nil
+		}
+		msg = msg.Get(fd).Message()
+	}
+	fd := this.path[len(this.path)-1]
+	value := msg.Get(fd)
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return value.Bool()
+	case protoreflect.EnumKind:
+		return int64(value.Enum())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return int64(value.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return value.Float()
+	case protoreflect.StringKind:
+		return value.String()
+	}
+	return value.Int()
+}
+
+// LiveColumns returns the columns of a table in the database and their types.
+func LiveColumns(db *sql.DB, table string) (map[string]string, error) {
+	rows, err := db.Query("SELECT column_name, data_type FROM information_schema.columns WHERE table_name = $1", table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := make(map[string]string)
+	for rows.Next() {
+		var name, dataType string
+		err = rows.Scan(&name, &dataType)
+		if err != nil {
+			return nil, err
+		}
+		result[name] = dataType
+	}
+	return result, rows.Err()
+}
+
+// Diff returns the changes migrating the live columns of a table to the model columns. New columns are
+// added, while dropping a column or changing its type loses data and is destructive.
+func Diff(table string, model []*Column, live map[string]string) []*types.SchemaChange {
+	changes := make([]*types.SchemaChange, 0)
+	inModel := make(map[string]bool, len(model))
+	for _, column := range model {
+		inModel[column.Name] = true
+		liveType, ok := live[column.Name]
+		if !ok {
+			changes = append(changes, &types.SchemaChange{Table: table, Column: column.Name,
+				Kind: types.SchemaChangeKind_SCHEMA_CHANGE_ADD_COLUMN, ToType: column.Type,
+				Statement: "ALTER TABLE " + table + " ADD COLUMN " + column.Name + " " + column.Type})
+		} else if liveType != column.Type {
+			//The values are repopulated from the data column
+			changes = append(changes, &types.SchemaChange{Table: table, Column: column.Name,
+				Kind: types.SchemaChangeKind_SCHEMA_CHANGE_ALTER_TYPE, FromType: liveType, ToType: column.Type,
+				Statement:   "ALTER TABLE " + table + " ALTER COLUMN " + column.Name + " TYPE " + column.Type + " USING NULL",
+				Destructive: true})
+		}
+	}
+	for name, liveType := range live {
+		if !inModel[name] && !baseColumns[name] {
+			changes = append(changes, &types.SchemaChange{Table: table, Column: name,
+				Kind: types.SchemaChangeKind_SCHEMA_CHANGE_DROP_COLUMN, FromType: liveType,
+				Statement: "ALTER TABLE " + table + " DROP COLUMN " + name, Destructive: true})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Column < changes[j].Column
+	})
+	return changes
+}
+
+// Plan diffs the model of the persisted types against the live schema, without changing it.
+func Plan(db *sql.DB, query *types.SchemaPlanQuery) (*types.SchemaPlan, error) {
+	version, err := SchemaVersion(db)
+	if err != nil {
+		return nil, err
+	}
+	plan := &types.SchemaPlan{Version: int32(version)}
+	for _, sample := range Persisted {
+		table := TableName(sample)
+		if query != nil && query.Table != "" && query.Table != table {
+			continue
+		}
+		live, err := LiveColumns(db, table)
+		if err != nil {
+			return nil, err
+		}
+		for _, change := range Diff(table, Columns(sample), live) {
+			plan.Changes = append(plan.Changes, change)
+			if change.Destructive {
+				plan.DestructiveCount++
+			}
+		}
+	}
+	return plan, nil
+}
+
+// ApplyPlan applies the changes of the plan as a single model migration, the destructive changes are
+// skipped unless approved. The tables that changed are backfilled from their data column.
+func ApplyPlan(db *sql.DB, plan *types.SchemaPlan, approveDestructive bool) (int, error) {
+	version, err := SchemaVersion(db)
+	if err != nil {
+		return 0, err
+	}
+	migration := &Migration{Version: max(version, MODEL_MIGRATION_BASE-1) + 1}
+	changed := make(map[string]bool)
+	for _, change := range plan.Changes {
+		if change.Destructive && !approveDestructive {
+			continue
+		}
+		migration.Statements = append(migration.Statements, change.Statement)
+		changed[change.Table] = true
+	}
+	if len(migration.Statements) == 0 {
+		return version, nil
+	}
+	migration.Name = "Model migration of " + strconv.Itoa(len(migration.Statements)) + " changes"
+	err = apply(db, migration)
+	if err != nil {
+		return version, err
+	}
+	for _, sample := range Persisted {
+		if changed[TableName(sample)] {
+			err = Backfill(db, sample)
+			if err != nil {
+				return migration.Version, err
+			}
+		}
+	}
+	return migration.Version, nil
+}
+
+// Backfill saves all the elements of a table again, populating its columns from the data column.
+func Backfill(db *sql.DB, sample proto.Message) error {
+	table, err := NewTable(db, sample)
+	if err != nil {
+		return err
+	}
+	rows, err := db.Query("SELECT id, data FROM " + table.name)
+	if err != nil {
+		return err
+	}
+	elems := make(map[string]proto.Message)
+	for rows.Next() {
+		var key string
+		var data []byte
+		err = rows.Scan(&key, &data)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		elem, err := table.unmarshal(data)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		elems[key] = elem
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return rows.Err()
+	}
+	for key, elem := range elems {
+		err = table.Save(key, elem)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persist
+
+import (
+	"database/sql"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	SchemaServiceName = "Schema"
+	SchemaServiceArea = byte(0)
+)
+
+// SchemaService reports the schema changes pending between the persisted types and the database,
+// as a dry run, so an operator can review the destructive ones before approving them.
+type SchemaService struct {
+	db *sql.DB
+}
+
+func ActivateSchema(db *sql.DB, vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&SchemaService{}, SchemaServiceName, SchemaServiceArea, false, nil)
+	sla.SetArgs(db)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", SchemaServiceName, ": ", err.Error())
+	}
+}
+
+func (this *SchemaService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	this.db = sla.Args()[0].(*sql.DB)
+	vnic.Resources().Registry().RegisterEnums(types.SchemaChangeKind_value)
+	vnic.Resources().Registry().Register(&types.SchemaPlanQuery{})
+	vnic.Resources().Registry().Register(&types.SchemaPlan{})
+	vnic.Resources().Registry().Register(&types.SchemaChange{})
+	return nil
+}
+
+func (this *SchemaService) DeActivate() error {
+	return nil
+}
+
+func (this *SchemaService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Post is not supported by " + SchemaServiceName)
+}
+
+func (this *SchemaService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Put is not supported by " + SchemaServiceName)
+}
+
+func (this *SchemaService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + SchemaServiceName)
+}
+
+func (this *SchemaService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Delete is not supported by " + SchemaServiceName)
+}
+
+// Get returns the plan of the queried table, or of all the persisted tables.
+func (this *SchemaService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, _ := pb.Element().(*types.SchemaPlanQuery)
+	plan, err := Plan(this.db, query)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, plan)
+}
+
+func (this *SchemaService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *SchemaService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *SchemaService) WebService() ifs.IWebService {
+	return web.New(SchemaServiceName, SchemaServiceArea, nil, nil, nil, nil, nil, nil, nil, nil,
+		&types.SchemaPlanQuery{}, &types.SchemaPlan{})
+}
@@ -0,0 +1,180 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persist
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Table stores the elements of a type as protobuf blobs keyed by their primary key, in a table
+// named after the type, e.g. networkdevice. The model columns the table has are populated as well,
+// so the elements can be queried with plain sql.
+type Table struct {
+	db      *sql.DB
+	name    string
+	sample  proto.Message
+	columns []*Column
+}
+
+// NewTable returns the table of the sample's type, the table is created by the schema migrations.
+func NewTable(db *sql.DB, sample proto.Message) (*Table, error) {
+	table := &Table{db: db, name: TableName(sample), sample: sample}
+	_, err := db.Exec("SELECT 1 FROM " + table.name + " LIMIT 0")
+	if err != nil {
+		return nil, errors.New("No table for " + table.name + ", is it missing a migration? " + err.Error())
+	}
+	live, err := LiveColumns(db, table.name)
+	if err != nil {
+		return nil, err
+	}
+	//A column pending a migration is left for the data column
+	for _, column := range Columns(sample) {
+		if live[column.Name] == column.Type {
+			table.columns = append(table.columns, column)
+		}
+	}
+	return table, nil
+}
+
+// TableName returns the table name of the element type, its lower case message name.
+func TableName(sample proto.Message) string {
+	return strings.ToLower(string(sample.ProtoReflect().Descriptor().Name()))
+}
+
+// Save inserts the element, or replaces it if it already exists.
+func (this *Table) Save(key string, elem proto.Message) error {
+	data, err := proto.Marshal(elem)
+	if err != nil {
+		return err
+	}
+	names := []string{"id", "data", "updated"}
+	values := []interface{}{key, data, time.Now().Unix()}
+	for _, column := range this.columns {
+		names = append(names, column.Name)
+		values = append(values, column.Value(elem))
+	}
+	params := make([]string, len(names))
+	updates := make([]string, len(names)-1)
+	for i, name := range names {
+		params[i] = "$" + strconv.Itoa(i+1)
+		if i > 0 {
+			updates[i-1] = name + " = EXCLUDED." + name
+		}
+	}
+	_, err = this.db.Exec("INSERT INTO "+this.name+" ("+strings.Join(names, ", ")+") VALUES ("+
+		strings.Join(params, ", ")+") ON CONFLICT (id) DO UPDATE SET "+strings.Join(updates, ", "), values...)
+	return err
+}
+
+func (this *Table) Delete(key string) error {
+	_, err := this.db.Exec("DELETE FROM "+this.name+" WHERE id = $1", key)
+	return err
+}
+
+// Load returns the element with the key, or nil if it does not exist.
+func (this *Table) Load(key string) (proto.Message, error) {
+	var data []byte
+	err := this.db.QueryRow("SELECT data FROM "+this.name+" WHERE id = $1", key).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return this.unmarshal(data)
+}
+
+// LoadAll returns all the elements, ordered by their key.
+func (this *Table) LoadAll() ([]proto.Message, error) {
+	rows, err := this.db.Query("SELECT data FROM " + this.name + " ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := make([]proto.Message, 0)
+	for rows.Next() {
+		var data []byte
+		err = rows.Scan(&data)
+		if err != nil {
+			return nil, err
+		}
+		elem, err := this.unmarshal(data)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, elem)
+	}
+	return result, rows.Err()
+}
+
+func (this *Table) unmarshal(data []byte) (proto.Message, error) {
+	elem := this.sample.ProtoReflect().New().Interface()
+	err := proto.Unmarshal(data, elem)
+	if err != nil {
+		return nil, err
+	}
+	return elem, nil
+}
+
+// Key returns the value of the primary key field of the element, the field name is matched case
+// insensitively, e.g. Id or Name.
+func Key(elem proto.Message, primaryKey string) string {
+	msg := elem.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if strings.EqualFold(string(fd.Name()), primaryKey) && fd.Kind() == protoreflect.StringKind {
+			return msg.Get(fd).String()
+		}
+	}
+	return ""
+}
+
+// NewList returns a list message, e.g. NetworkDeviceList, holding the elements in its list field.
+func NewList(sample proto.Message, elems []proto.Message) (proto.Message, error) {
+	list := sample.ProtoReflect().New()
+	fd := list.Descriptor().Fields().ByName("list")
+	if fd == nil || !fd.IsList() {
+		return nil, errors.New("No list field in " + string(list.Descriptor().Name()))
+	}
+	values := list.Mutable(fd).List()
+	for _, elem := range elems {
+		values.Append(protoreflect.ValueOfMessage(elem.ProtoReflect()))
+	}
+	return list.Interface(), nil
+}
+
+// Elements returns the elements of a list message.
+func Elements(list proto.Message) []proto.Message {
+	msg := list.ProtoReflect()
+	fd := msg.Descriptor().Fields().ByName("list")
+	if fd == nil || !fd.IsList() {
+		return nil
+	}
+	values := msg.Get(fd).List()
+	result := make([]proto.Message, values.Len())
+	for i := 0; i < values.Len(); i++ {
+		result[i] = values.Get(i).Message().Interface()
+	}
+	return result
+}
@@ -0,0 +1,154 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persist
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/saichler/probler/go/types"
+)
+
+// The table of the user defined tags, a row per tag so they are indexed by their key and value.
+const TAG_TABLE = "tag"
+
+var tagKeyExpr = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+var tagFilterExpr = regexp.MustCompile(`(?i)\btag\.([A-Za-z0-9_-]+)\s*=\s*'?([^\s']+)'?`)
+
+// CreateTagTables returns the statements creating the tag table and its key and value index.
+func CreateTagTables() []string {
+	return []string{"CREATE TABLE IF NOT EXISTS " + TAG_TABLE +
+		" (id TEXT NOT NULL, key TEXT NOT NULL, value TEXT NOT NULL, PRIMARY KEY (id, key))",
+		"CREATE INDEX IF NOT EXISTS " + TAG_TABLE + "_key_value ON " + TAG_TABLE + " (key, value)"}
+}
+
+// TagStore stores the user defined tags of the targets, keyed by the target id, which is also the id
+// of the element polled from the target. The tags are never part of the polled data, so a poll does not
+// overwrite them.
+type TagStore struct {
+	db *sql.DB
+}
+
+func NewTagStore(db *sql.DB) *TagStore {
+	return &TagStore{db: db}
+}
+
+// ValidateTags checks the tag keys are words, so they can be used in a query, and the set tags have a value.
+func ValidateTags(set map[string]string, remove []string) error {
+	for key, value := range set {
+		if !tagKeyExpr.MatchString(key) {
+			return errors.New("Invalid tag key '" + key + "', expected letters, digits, '_' or '-'")
+		}
+		if value == "" {
+			return errors.New("Tag " + key + " has no value")
+		}
+	}
+	for _, key := range remove {
+		if !tagKeyExpr.MatchString(key) {
+			return errors.New("Invalid tag key '" + key + "', expected letters, digits, '_' or '-'")
+		}
+	}
+	return nil
+}
+
+// Tags returns the tags of the id, empty when it has none.
+func (this *TagStore) Tags(id string) (map[string]string, error) {
+	sets, err := this.query("SELECT id, key, value FROM "+TAG_TABLE+" WHERE id = $1", id)
+	if err != nil || len(sets) == 0 {
+		return map[string]string{}, err
+	}
+	return sets[0].Tags, nil
+}
+
+// Find returns the tag sets having all the filter tags, or all the tag sets when there are no filters.
+func (this *TagStore) Find(filters map[string]string) ([]*types.TagSet, error) {
+	if len(filters) == 0 {
+		return this.query("SELECT id, key, value FROM " + TAG_TABLE + " ORDER BY id")
+	}
+	conditions := make([]string, 0, len(filters))
+	args := make([]interface{}, 0, len(filters)*2)
+	for _, key := range sortedKeys(filters) {
+		conditions = append(conditions, "(key = $"+strconv.Itoa(len(args)+1)+" AND value = $"+strconv.Itoa(len(args)+2)+")")
+		args = append(args, key, filters[key])
+	}
+	return this.query("SELECT id, key, value FROM "+TAG_TABLE+" WHERE id IN (SELECT id FROM "+TAG_TABLE+
+		" WHERE "+strings.Join(conditions, " OR ")+" GROUP BY id HAVING COUNT(*) = "+strconv.Itoa(len(filters))+
+		") ORDER BY id", args...)
+}
+
+// Update sets and removes the tags of the ids in a single transaction. When replace is true the ids
+// are left with only the set tags.
+func (this *TagStore) Update(ids []string, set map[string]string, remove []string, replace bool) error {
+	tx, err := this.db.Begin()
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if replace {
+			_, err = tx.Exec("DELETE FROM "+TAG_TABLE+" WHERE id = $1", id)
+		}
+		for _, key := range remove {
+			if err == nil {
+				_, err = tx.Exec("DELETE FROM "+TAG_TABLE+" WHERE id = $1 AND key = $2", id, key)
+			}
+		}
+		for _, key := range sortedKeys(set) {
+			if err == nil {
+				_, err = tx.Exec("INSERT INTO "+TAG_TABLE+" (id, key, value) VALUES ($1, $2, $3) "+
+					"ON CONFLICT (id, key) DO UPDATE SET value = EXCLUDED.value", id, key, set[key])
+			}
+		}
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (this *TagStore) query(query string, args ...interface{}) ([]*types.TagSet, error) {
+	rows, err := this.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := make([]*types.TagSet, 0)
+	for rows.Next() {
+		var id, key, value string
+		err = rows.Scan(&id, &key, &value)
+		if err != nil {
+			return nil, err
+		}
+		if len(result) == 0 || result[len(result)-1].Id != id {
+			result = append(result, &types.TagSet{Id: id, Tags: make(map[string]string)})
+		}
+		result[len(result)-1].Tags[key] = value
+	}
+	return result, rows.Err()
+}
+
+// TagFilters returns the tag clauses of a query, e.g. env=prod of
+// "select * from NetworkDevice where tag.env=prod".
+func TagFilters(text string) map[string]string {
+	filters := make(map[string]string)
+	for _, match := range tagFilterExpr.FindAllStringSubmatch(text, -1) {
+		filters[match[1]] = match[2]
+	}
+	return filters
+}
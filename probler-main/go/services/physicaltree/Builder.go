@@ -0,0 +1,180 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package physicaltree
+
+import (
+	"sort"
+
+	"github.com/saichler/probler/go/types"
+)
+
+// Build reconstructs the ENTITY-MIB containment tree (chassis -> slot -> module -> port/sensor)
+// of a device from its flat physicals map, using entPhysicalContainedIn to link each entity
+// to its parent and entPhysicalParentRelPos to order siblings.
+func Build(device *types.NetworkDevice) *types.PhysicalTree {
+	tree := &types.PhysicalTree{DeviceId: device.Id}
+	tree.Roots = make([]*types.PhysicalNode, 0)
+	tree.Orphans = make([]*types.PhysicalNode, 0)
+	tree.IndexGaps = make([]*types.PhysicalIndexGap, 0)
+	tree.DuplicateIndexes = make([]uint32, 0)
+
+	keys := make([]string, 0, len(device.Physicals))
+	for key := range device.Physicals {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	byIndex := make(map[uint32]*types.PhysicalNode)
+	indexed := make([]*types.PhysicalNode, 0)
+	for _, key := range keys {
+		physical := device.Physicals[key]
+		if physical == nil {
+			continue
+		}
+		tree.EntityCount++
+		node := &types.PhysicalNode{Id: key,
+			PhysicalIndex: physical.PhysicalIndex,
+			ContainedIn:   physical.ContainedIn,
+			ParentRelPos:  physical.ParentRelPos,
+			PhysicalClass: physical.PhysicalClass}
+		//Entities without an entPhysicalIndex cannot be referenced, so they can only be roots
+		if node.PhysicalIndex == 0 {
+			tree.Roots = append(tree.Roots, node)
+			continue
+		}
+		_, exist := byIndex[node.PhysicalIndex]
+		if exist {
+			tree.DuplicateIndexes = append(tree.DuplicateIndexes, node.PhysicalIndex)
+			tree.Orphans = append(tree.Orphans, node)
+			continue
+		}
+		byIndex[node.PhysicalIndex] = node
+		indexed = append(indexed, node)
+	}
+
+	sort.Slice(indexed, func(i, j int) bool {
+		return indexed[i].PhysicalIndex < indexed[j].PhysicalIndex
+	})
+
+	parents := make(map[uint32]*types.PhysicalNode)
+	for _, node := range indexed {
+		if node.ContainedIn == 0 {
+			tree.Roots = append(tree.Roots, node)
+			continue
+		}
+		parent, ok := byIndex[node.ContainedIn]
+		if !ok || parent == node {
+			tree.Orphans = append(tree.Orphans, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+		parents[node.PhysicalIndex] = parent
+	}
+
+	visited := make(map[*types.PhysicalNode]bool)
+	for _, node := range tree.Roots {
+		markVisited(node, visited)
+	}
+	for _, node := range tree.Orphans {
+		markVisited(node, visited)
+	}
+
+	//Whatever was not reached from a root or an orphan is part of a containment cycle or below one,
+	//break the cycle at its lowest index member and report it as an orphan, with what is below it.
+	for _, node := range indexed {
+		if visited[node] {
+			continue
+		}
+		member := cycleMember(node, parents)
+		parent := parents[member.PhysicalIndex]
+		parent.Children = removeChild(parent.Children, member)
+		tree.Orphans = append(tree.Orphans, member)
+		markVisited(member, visited)
+	}
+
+	for _, node := range tree.Roots {
+		sortAndSetDepth(node, 0)
+	}
+	for _, node := range tree.Orphans {
+		sortAndSetDepth(node, 0)
+	}
+
+	tree.IndexGaps = indexGaps(indexed)
+	return tree
+}
+
+func markVisited(node *types.PhysicalNode, visited map[*types.PhysicalNode]bool) {
+	if visited[node] {
+		return
+	}
+	visited[node] = true
+	for _, child := range node.Children {
+		markVisited(child, visited)
+	}
+}
+
+// cycleMember walks up the parents of a node, which leads to a cycle when the node was not reached
+// from a root or an orphan, and returns the cycle's member with the lowest index.
+func cycleMember(node *types.PhysicalNode, parents map[uint32]*types.PhysicalNode) *types.PhysicalNode {
+	seen := make(map[*types.PhysicalNode]bool)
+	for !seen[node] {
+		seen[node] = true
+		node = parents[node.PhysicalIndex]
+	}
+	lowest := node
+	for member := parents[node.PhysicalIndex]; member != node; member = parents[member.PhysicalIndex] {
+		if member.PhysicalIndex < lowest.PhysicalIndex {
+			lowest = member
+		}
+	}
+	return lowest
+}
+
+func removeChild(children []*types.PhysicalNode, node *types.PhysicalNode) []*types.PhysicalNode {
+	for i, child := range children {
+		if child == node {
+			return append(children[:i], children[i+1:]...)
+		}
+	}
+	return children
+}
+
+func sortAndSetDepth(node *types.PhysicalNode, depth uint32) {
+	node.Depth = depth
+	sort.SliceStable(node.Children, func(i, j int) bool {
+		if node.Children[i].ParentRelPos != node.Children[j].ParentRelPos {
+			return node.Children[i].ParentRelPos < node.Children[j].ParentRelPos
+		}
+		return node.Children[i].PhysicalIndex < node.Children[j].PhysicalIndex
+	})
+	for _, child := range node.Children {
+		sortAndSetDepth(child, depth+1)
+	}
+}
+
+// indexGaps returns the unused index ranges between the lowest and the highest entPhysicalIndex,
+// the given nodes are expected to be sorted by their index.
+func indexGaps(sorted []*types.PhysicalNode) []*types.PhysicalIndexGap {
+	gaps := make([]*types.PhysicalIndexGap, 0)
+	for i := 1; i < len(sorted); i++ {
+		prev := sorted[i-1].PhysicalIndex
+		curr := sorted[i].PhysicalIndex
+		if curr-prev > 1 {
+			gaps = append(gaps, &types.PhysicalIndexGap{First: prev + 1, Last: curr - 1})
+		}
+	}
+	return gaps
+}
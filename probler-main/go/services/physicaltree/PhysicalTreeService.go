@@ -0,0 +1,109 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package physicaltree
+
+import (
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	ServiceName = "PhysTree"
+	ServiceArea = byte(0)
+)
+
+// PhysicalTreeService serves the physical containment tree of the devices in the
+// network device cache, the tree is built on demand from the latest polled physicals.
+type PhysicalTreeService struct {
+	vnic ifs.IVNic
+}
+
+func Activate(vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&PhysicalTreeService{}, ServiceName, ServiceArea, false, nil)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ServiceName, ": ", err.Error())
+	}
+}
+
+func (this *PhysicalTreeService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	this.vnic = vnic
+	vnic.Resources().Registry().Register(&types.PhysicalTreeQuery{})
+	vnic.Resources().Registry().Register(&types.PhysicalTree{})
+	vnic.Resources().Registry().Register(&types.PhysicalTreeList{})
+	return nil
+}
+
+func (this *PhysicalTreeService) DeActivate() error {
+	this.vnic = nil
+	return nil
+}
+
+func (this *PhysicalTreeService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Post is not supported by " + ServiceName)
+}
+
+func (this *PhysicalTreeService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Put is not supported by " + ServiceName)
+}
+
+func (this *PhysicalTreeService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ServiceName)
+}
+
+func (this *PhysicalTreeService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Delete is not supported by " + ServiceName)
+}
+
+func (this *PhysicalTreeService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, _ := pb.Element().(*types.PhysicalTreeQuery)
+	var devices []*types.NetworkDevice
+	if query != nil && query.DeviceId != "" {
+		device, err := common.NetworkDevice(query.DeviceId, this.vnic)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		devices = []*types.NetworkDevice{device}
+	} else {
+		list, err := common.NetworkDevices(this.vnic)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		devices = list
+	}
+
+	trees := &types.PhysicalTreeList{List: make([]*types.PhysicalTree, 0, len(devices))}
+	for _, device := range devices {
+		trees.List = append(trees.List, Build(device))
+	}
+	return object.New(nil, trees)
+}
+
+func (this *PhysicalTreeService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *PhysicalTreeService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *PhysicalTreeService) WebService() ifs.IWebService {
+	return web.New(ServiceName, ServiceArea, nil, nil, nil, nil, nil, nil, nil, nil,
+		&types.PhysicalTreeQuery{}, &types.PhysicalTreeList{})
+}
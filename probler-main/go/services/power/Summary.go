@@ -0,0 +1,136 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package power
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/saichler/probler/go/types"
+)
+
+// CIRCUIT_LOAD_LIMIT is the percentage of the rated current a circuit may continuously carry.
+const CIRCUIT_LOAD_LIMIT = 80.0
+
+// The scales of the circuit current, in tenths of amps, and power, in hundredths of kW, as rPDU2
+// reports them. The rating is in whole amps.
+const (
+	CIRCUIT_CURRENT_SCALE = 0.1
+	CIRCUIT_POWER_SCALE   = 10.0
+)
+
+// Summarize aggregates the per circuit loads, the UPS battery runtimes and the PUE
+// of the power devices matching the query.
+func Summarize(devices []*types.PowerDevice, query *types.PowerSummaryQuery) *types.PowerSummary {
+	sorted := make([]*types.PowerDevice, 0, len(devices))
+	for _, device := range devices {
+		if device == nil {
+			continue
+		}
+		if query != nil && query.RackId != "" && (device.Info == nil || device.Info.RackId != query.RackId) {
+			continue
+		}
+		sorted = append(sorted, device)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Id < sorted[j].Id
+	})
+
+	summary := &types.PowerSummary{Circuits: make([]*types.CircuitLoad, 0), Upses: make([]*types.UpsRuntime, 0)}
+	for _, device := range sorted {
+		name := ""
+		if device.Info != nil {
+			name = device.Info.Name
+		}
+		if device.Pdu != nil {
+			summary.ItPowerW += pduPower(device.Pdu)
+			summary.Circuits = append(summary.Circuits, circuitLoads(device.Id, device.Pdu)...)
+		}
+		if device.Ups != nil {
+			ups := device.Ups
+			summary.FacilityPowerW += ups.InputPowerW
+			summary.Upses = append(summary.Upses, &types.UpsRuntime{PowerDeviceId: device.Id,
+				Name:                  name,
+				BatteryStatus:         ups.BatteryStatus,
+				BatteryChargePercent:  ups.BatteryChargePercent,
+				BatteryRuntimeMinutes: ups.BatteryRuntimeMinutes,
+				OnBattery:             ups.OutputSource == types.UpsOutputSource_UPS_OUTPUT_SOURCE_BATTERY || ups.SecondsOnBattery > 0})
+		}
+	}
+	if summary.ItPowerW > 0 && summary.FacilityPowerW > 0 {
+		summary.Pue = summary.FacilityPowerW / summary.ItPowerW
+	}
+	return summary
+}
+
+// pduPower is the power reported by the PDU, or the sum of its outlets or of its
+// phases when the PDU does not report a total.
+func pduPower(pdu *types.Pdu) float64 {
+	if pdu.PowerW > 0 {
+		return pdu.PowerW
+	}
+	total := 0.0
+	for _, outlet := range pdu.Outlets {
+		if outlet != nil {
+			total += outlet.PowerW
+		}
+	}
+	if total > 0 {
+		return total
+	}
+	for _, circuit := range pdu.Circuits {
+		if circuit != nil {
+			total += circuit.PowerDecaW * CIRCUIT_POWER_SCALE
+		}
+	}
+	return total
+}
+
+func circuitLoads(deviceId string, pdu *types.Pdu) []*types.CircuitLoad {
+	keys := make([]string, 0, len(pdu.Circuits))
+	for key := range pdu.Circuits {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	loads := make([]*types.CircuitLoad, 0, len(keys))
+	for _, key := range keys {
+		circuit := pdu.Circuits[key]
+		if circuit == nil {
+			continue
+		}
+		name := circuit.Name
+		if name == "" {
+			name = circuitName(key)
+		}
+		load := &types.CircuitLoad{PowerDeviceId: deviceId, CircuitId: key, Name: name,
+			CurrentA: circuit.CurrentDeciA * CIRCUIT_CURRENT_SCALE, RatedCurrentA: circuit.RatedCurrentA}
+		if circuit.RatedCurrentA > 0 {
+			load.LoadPercent = load.CurrentA / circuit.RatedCurrentA * 100
+			load.Overloaded = load.LoadPercent >= CIRCUIT_LOAD_LIMIT
+		}
+		loads = append(loads, load)
+	}
+	return loads
+}
+
+// circuitName names a phase by its rPDU2 table index.
+func circuitName(key string) string {
+	if _, err := strconv.Atoi(key); err == nil {
+		return "Phase " + key
+	}
+	return key
+}
@@ -0,0 +1,89 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package racks
+
+import (
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	ElevationServiceName = "RackElev"
+	ElevationServiceArea = byte(0)
+)
+
+// ElevationService renders the elevation of a rack for the UI.
+type ElevationService struct {
+	vnic ifs.IVNic
+}
+
+func (this *ElevationService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	this.vnic = vnic
+	vnic.Resources().Registry().Register(&types.RackElevationQuery{})
+	vnic.Resources().Registry().Register(&types.RackElevation{})
+	return nil
+}
+
+func (this *ElevationService) DeActivate() error {
+	this.vnic = nil
+	return nil
+}
+
+func (this *ElevationService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Post is not supported by " + ElevationServiceName)
+}
+
+func (this *ElevationService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Put is not supported by " + ElevationServiceName)
+}
+
+func (this *ElevationService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ElevationServiceName)
+}
+
+func (this *ElevationService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Delete is not supported by " + ElevationServiceName)
+}
+
+func (this *ElevationService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, ok := pb.Element().(*types.RackElevationQuery)
+	if !ok || query.RackId == "" {
+		return object.NewError("Expected a rack elevation query with a rack Id")
+	}
+	list, err := Racks(query.RackId, this.vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	if len(list) == 0 {
+		return object.NewError("Rack " + query.RackId + " was not found")
+	}
+	return object.New(nil, Elevation(list[0]))
+}
+
+func (this *ElevationService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *ElevationService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *ElevationService) WebService() ifs.IWebService {
+	return web.New(ElevationServiceName, ElevationServiceArea, nil, nil, nil, nil, nil, nil, nil, nil,
+		&types.RackElevationQuery{}, &types.RackElevation{})
+}
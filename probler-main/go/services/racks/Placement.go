@@ -0,0 +1,126 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package racks
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/saichler/probler/go/types"
+)
+
+const DEFAULT_RACK_HEIGHT_U = 42
+
+// Validate checks that every placement fits inside the rack, that no two placements occupy
+// the same U on the same face and that the rack weight and power budgets are not exceeded.
+func Validate(rack *types.Rack) error {
+	if rack.Id == "" {
+		return errors.New("Rack has no Id")
+	}
+	height := rackHeight(rack)
+	devices := make(map[string]bool)
+	weight := 0.0
+	power := 0.0
+	for i, p := range rack.Placements {
+		if p == nil {
+			return errors.New("Rack " + rack.Id + " has an empty placement")
+		}
+		if p.DeviceId == "" {
+			return errors.New("Placement " + strconv.Itoa(i) + " in rack " + rack.Id + " has no device")
+		}
+		if devices[p.DeviceId] {
+			return errors.New("Device " + p.DeviceId + " is placed more than once in rack " + rack.Id)
+		}
+		devices[p.DeviceId] = true
+		//Compared without adding the position and the height, which may overflow
+		if p.PositionU == 0 || p.HeightU == 0 || p.HeightU > height || p.PositionU > height-p.HeightU+1 {
+			return errors.New("Device " + p.DeviceId + " does not fit in rack " + rack.Id)
+		}
+		for _, other := range rack.Placements[:i] {
+			if overlap(p, other) {
+				return errors.New("Device " + p.DeviceId + " overlaps device " + other.DeviceId + " in rack " + rack.Id)
+			}
+		}
+		weight += p.WeightKg
+		power += p.PowerW
+	}
+	if rack.MaxWeightKg > 0 && weight > rack.MaxWeightKg {
+		return errors.New("Rack " + rack.Id + " exceeds its weight budget of " +
+			strconv.FormatFloat(rack.MaxWeightKg, 'f', -1, 64) + "kg")
+	}
+	if rack.PowerBudgetW > 0 && power > rack.PowerBudgetW {
+		return errors.New("Rack " + rack.Id + " exceeds its power budget of " +
+			strconv.FormatFloat(rack.PowerBudgetW, 'f', -1, 64) + "W")
+	}
+	return nil
+}
+
+// Elevation renders the rack U by U, from the top of the rack down, with the front and rear occupants.
+func Elevation(rack *types.Rack) *types.RackElevation {
+	height := rackHeight(rack)
+	elevation := &types.RackElevation{RackId: rack.Id, Name: rack.Name, HeightU: height,
+		MaxWeightKg: rack.MaxWeightKg, PowerBudgetW: rack.PowerBudgetW,
+		Units: make([]*types.RackUnit, height)}
+	for i := range elevation.Units {
+		elevation.Units[i] = &types.RackUnit{Position: height - uint32(i)}
+	}
+	for _, p := range rack.Placements {
+		if p == nil {
+			continue
+		}
+		elevation.WeightKg += p.WeightKg
+		elevation.PowerW += p.PowerW
+		for u := p.PositionU; u < p.PositionU+p.HeightU && u <= height; u++ {
+			if u == 0 {
+				continue
+			}
+			unit := elevation.Units[height-u]
+			if occupies(p, types.RackFace_RACK_FACE_FRONT) {
+				unit.FrontDeviceId = p.DeviceId
+			}
+			if occupies(p, types.RackFace_RACK_FACE_REAR) {
+				unit.RearDeviceId = p.DeviceId
+			}
+		}
+	}
+	for _, unit := range elevation.Units {
+		if unit.FrontDeviceId != "" || unit.RearDeviceId != "" {
+			elevation.UsedU++
+		}
+	}
+	elevation.FreeU = height - elevation.UsedU
+	return elevation
+}
+
+func rackHeight(rack *types.Rack) uint32 {
+	if rack.HeightU == 0 {
+		return DEFAULT_RACK_HEIGHT_U
+	}
+	return rack.HeightU
+}
+
+// occupies returns true if the placement takes the given face, full depth devices take both faces.
+func occupies(p *types.RackPlacement, face types.RackFace) bool {
+	return p.Depth == types.RackDepth_RACK_DEPTH_FULL || p.Face == face
+}
+
+func overlap(a, b *types.RackPlacement) bool {
+	if a.PositionU+a.HeightU <= b.PositionU || b.PositionU+b.HeightU <= a.PositionU {
+		return false
+	}
+	return (occupies(a, types.RackFace_RACK_FACE_FRONT) && occupies(b, types.RackFace_RACK_FACE_FRONT)) ||
+		(occupies(a, types.RackFace_RACK_FACE_REAR) && occupies(b, types.RackFace_RACK_FACE_REAR))
+}
@@ -0,0 +1,209 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package racks
+
+import (
+	"database/sql"
+	"errors"
+	"sync"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	ServiceName = "Racks"
+	ServiceArea = byte(0)
+)
+
+// RackService is the inventory of racks and the devices placed in them, keyed by the rack Id, in the
+// orm database so they survive a restart. A rack is only accepted if its placements pass the
+// placement rules, and a device can only be placed in a single rack.
+type RackService struct {
+	table *persist.Table
+	mtx   *sync.Mutex
+}
+
+// Activate activates the rack row, rack and rack elevation services.
+func Activate(db *sql.DB, vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&RowService{}, RowServiceName, RowServiceArea, false, nil)
+	sla.SetArgs(db)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", RowServiceName, ": ", err.Error())
+	}
+	sla = ifs.NewServiceLevelAgreement(&RackService{}, ServiceName, ServiceArea, false, nil)
+	sla.SetArgs(db)
+	_, err = vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ServiceName, ": ", err.Error())
+	}
+	sla = ifs.NewServiceLevelAgreement(&ElevationService{}, ElevationServiceName, ElevationServiceArea, false, nil)
+	_, err = vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ElevationServiceName, ": ", err.Error())
+	}
+}
+
+// Racks fetches the rack with the given Id, or all the racks when the Id is empty, from the rack service.
+func Racks(id string, vnic ifs.IVNic) ([]*types.Rack, error) {
+	resp := vnic.Request("", ServiceName, ServiceArea, ifs.GET, &types.Rack{Id: id}, common.INVENTORY_REQUEST_TIMEOUT)
+	if resp == nil {
+		return nil, errors.New("No response from " + ServiceName)
+	}
+	if resp.Error() != nil {
+		return nil, resp.Error()
+	}
+	list, ok := resp.Element().(*types.RackList)
+	if !ok {
+		return nil, errors.New("Unexpected response type from " + ServiceName)
+	}
+	return list.List, nil
+}
+
+func (this *RackService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	table, err := persist.NewTable(sla.Args()[0].(*sql.DB), &types.Rack{})
+	if err != nil {
+		return err
+	}
+	this.table = table
+	this.mtx = &sync.Mutex{}
+	vnic.Resources().Registry().Register(&types.Rack{})
+	vnic.Resources().Registry().Register(&types.RackList{})
+	vnic.Resources().Registry().Register(&types.RackPlacement{})
+	return nil
+}
+
+func (this *RackService) DeActivate() error {
+	this.table = nil
+	return nil
+}
+
+func (this *RackService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	rack, ok := pb.Element().(*types.Rack)
+	if !ok {
+		return object.NewError("Expected a rack")
+	}
+	err := Validate(rack)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	racks, err := this.racks()
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	for _, p := range rack.Placements {
+		other := rackOf(racks, p.DeviceId)
+		if other != "" && other != rack.Id {
+			return object.NewError("Device " + p.DeviceId + " is already placed in rack " + other)
+		}
+	}
+	err = this.table.Save(rack.Id, rack)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, rack)
+}
+
+func (this *RackService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.Post(pb, vnic)
+}
+
+func (this *RackService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ServiceName)
+}
+
+func (this *RackService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	rack, ok := pb.Element().(*types.Rack)
+	if !ok || rack.Id == "" {
+		return object.NewError("Expected a rack with an Id")
+	}
+	err := this.table.Delete(rack.Id)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, rack)
+}
+
+// Get returns the rack with the given Id, or all the racks when the Id is empty.
+func (this *RackService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	list := &types.RackList{List: make([]*types.Rack, 0)}
+	rack, ok := pb.Element().(*types.Rack)
+	if ok && rack.Id != "" {
+		elem, err := this.table.Load(rack.Id)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		if elem != nil {
+			list.List = append(list.List, elem.(*types.Rack))
+		}
+		return object.New(nil, list)
+	}
+	racks, err := this.racks()
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	list.List = racks
+	return object.New(nil, list)
+}
+
+// racks loads all the racks, ordered by their Id.
+func (this *RackService) racks() ([]*types.Rack, error) {
+	elems, err := this.table.LoadAll()
+	if err != nil {
+		return nil, err
+	}
+	racks := make([]*types.Rack, 0, len(elems))
+	for _, elem := range elems {
+		racks = append(racks, elem.(*types.Rack))
+	}
+	return racks, nil
+}
+
+// rackOf returns the Id of the rack the device is placed in.
+func rackOf(racks []*types.Rack, deviceId string) string {
+	for _, rack := range racks {
+		for _, p := range rack.Placements {
+			if p.DeviceId == deviceId {
+				return rack.Id
+			}
+		}
+	}
+	return ""
+}
+
+func (this *RackService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *RackService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *RackService) WebService() ifs.IWebService {
+	return web.New(ServiceName, ServiceArea,
+		&types.Rack{}, &types.Rack{},
+		&types.Rack{}, &types.Rack{},
+		nil, nil,
+		&types.Rack{}, &types.Rack{},
+		&types.Rack{}, &types.RackList{})
+}
@@ -0,0 +1,143 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package racks
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	RowServiceName = "RackRows"
+	RowServiceArea = byte(0)
+)
+
+// RowService is the inventory of rack rows, keyed by the row Id, in the orm database.
+type RowService struct {
+	table *persist.Table
+}
+
+// Rows fetches the row with the given Id, or all the rows when the Id is empty, from the rack row service.
+func Rows(id string, vnic ifs.IVNic) ([]*types.RackRow, error) {
+	resp := vnic.Request("", RowServiceName, RowServiceArea, ifs.GET, &types.RackRow{Id: id}, common.INVENTORY_REQUEST_TIMEOUT)
+	if resp == nil {
+		return nil, errors.New("No response from " + RowServiceName)
+	}
+	if resp.Error() != nil {
+		return nil, resp.Error()
+	}
+	list, ok := resp.Element().(*types.RackRowList)
+	if !ok {
+		return nil, errors.New("Unexpected response type from " + RowServiceName)
+	}
+	return list.List, nil
+}
+
+func (this *RowService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	table, err := persist.NewTable(sla.Args()[0].(*sql.DB), &types.RackRow{})
+	if err != nil {
+		return err
+	}
+	this.table = table
+	vnic.Resources().Registry().Register(&types.RackRow{})
+	vnic.Resources().Registry().Register(&types.RackRowList{})
+	return nil
+}
+
+func (this *RowService) DeActivate() error {
+	this.table = nil
+	return nil
+}
+
+func (this *RowService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	row, ok := pb.Element().(*types.RackRow)
+	if !ok || row.Id == "" {
+		return object.NewError("Expected a rack row with an Id")
+	}
+	err := this.table.Save(row.Id, row)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, row)
+}
+
+func (this *RowService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.Post(pb, vnic)
+}
+
+func (this *RowService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + RowServiceName)
+}
+
+func (this *RowService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	row, ok := pb.Element().(*types.RackRow)
+	if !ok || row.Id == "" {
+		return object.NewError("Expected a rack row with an Id")
+	}
+	err := this.table.Delete(row.Id)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, row)
+}
+
+// Get returns the row with the given Id, or all the rows when the Id is empty.
+func (this *RowService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	list := &types.RackRowList{List: make([]*types.RackRow, 0)}
+	row, ok := pb.Element().(*types.RackRow)
+	if ok && row.Id != "" {
+		elem, err := this.table.Load(row.Id)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		if elem != nil {
+			list.List = append(list.List, elem.(*types.RackRow))
+		}
+		return object.New(nil, list)
+	}
+	elems, err := this.table.LoadAll()
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	for _, elem := range elems {
+		list.List = append(list.List, elem.(*types.RackRow))
+	}
+	return object.New(nil, list)
+}
+
+func (this *RowService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *RowService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *RowService) WebService() ifs.IWebService {
+	return web.New(RowServiceName, RowServiceArea,
+		&types.RackRow{}, &types.RackRow{},
+		&types.RackRow{}, &types.RackRow{},
+		nil, nil,
+		&types.RackRow{}, &types.RackRow{},
+		&types.RackRow{}, &types.RackRowList{})
+}
@@ -0,0 +1,342 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package search
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	DEFAULT_SEARCH_LIMIT = 50
+	// Shorter words are too ambiguous to match with a typo
+	MIN_FUZZY_LENGTH = 4
+	// Longer words may be matched with two typos
+	MIN_TWO_TYPOS_LENGTH = 8
+)
+
+// The score of a word matching a field, by how it matches, before the field's weight.
+const (
+	SCORE_EXACT     = 100.0
+	SCORE_PREFIX    = 80.0
+	SCORE_WORD      = 60.0
+	SCORE_SUBSTRING = 40.0
+	SCORE_FUZZY     = 20.0
+)
+
+type fieldKind int
+
+const (
+	textField fieldKind = iota
+	ipField
+	macField
+)
+
+// Index is a searchable snapshot of the inventory, a document per device, interface and k8s object.
+type Index struct {
+	documents []*document
+}
+
+type document struct {
+	kind   types.SearchKind
+	id     string
+	name   string
+	fields []*field
+}
+
+type field struct {
+	name   string
+	value  string
+	text   string
+	words  []string
+	weight float64
+	kind   fieldKind
+}
+
+// NewIndex indexes the devices, their interfaces and the clusters' objects.
+func NewIndex(devices []*types.NetworkDevice, clusters []*types.K8SCluster) *Index {
+	index := &Index{documents: make([]*document, 0)}
+	for _, device := range devices {
+		if device != nil {
+			index.addDevice(device)
+		}
+	}
+	for _, cluster := range clusters {
+		if cluster != nil {
+			index.addCluster(cluster)
+		}
+	}
+	return index
+}
+
+// Size is the number of indexed documents.
+func (this *Index) Size() int {
+	return len(this.documents)
+}
+
+func (this *Index) addDevice(device *types.NetworkDevice) {
+	doc := this.add(types.SearchKind_SEARCH_KIND_DEVICE, device.Id, "")
+	doc.field("id", device.Id, 1, textField)
+	info := device.Equipmentinfo
+	if info != nil {
+		doc.name = info.SysName
+		doc.field("sys_name", info.SysName, 1, textField)
+		doc.field("serial_number", info.SerialNumber, 1, textField)
+		doc.field("ip_address", info.IpAddress, 1, ipField)
+		doc.field("model", info.Model, 0.7, textField)
+	}
+	for _, iface := range common.Interfaces(device) {
+		name := iface.Name
+		if name == "" {
+			name = iface.Id
+		}
+		doc = this.add(types.SearchKind_SEARCH_KIND_INTERFACE, device.Id, name)
+		doc.field("name", name, 0.9, textField)
+		doc.field("description", iface.Description, 0.6, textField)
+		doc.field("mac_address", iface.MacAddress, 1, macField)
+		doc.field("ip_address", iface.IpAddress, 1, ipField)
+	}
+}
+
+func (this *Index) addCluster(cluster *types.K8SCluster) {
+	this.add(types.SearchKind_SEARCH_KIND_K8S_CLUSTER, cluster.Name, cluster.Name).field("name", cluster.Name, 1, textField)
+	for _, key := range sortedKeys(cluster.Nodes) {
+		node := cluster.Nodes[key]
+		doc := this.add(types.SearchKind_SEARCH_KIND_K8S_NODE, cluster.Name, node.Name)
+		doc.field("name", node.Name, 1, textField)
+		doc.field("internal_ip", node.InternalIp, 1, ipField)
+		doc.field("external_ip", node.ExternalIp, 1, ipField)
+	}
+	for _, key := range sortedKeys(cluster.Pods) {
+		pod := cluster.Pods[key]
+		doc := this.addNamespaced(types.SearchKind_SEARCH_KIND_K8S_POD, cluster.Name, pod.Namespace, pod.Name)
+		doc.field("ip", pod.Ip, 0.9, ipField)
+	}
+	for _, key := range sortedKeys(cluster.Deployments) {
+		deployment := cluster.Deployments[key]
+		this.addNamespaced(types.SearchKind_SEARCH_KIND_K8S_DEPLOYMENT, cluster.Name, deployment.Namespace, deployment.Name)
+	}
+	for _, key := range sortedKeys(cluster.Statefulsets) {
+		set := cluster.Statefulsets[key]
+		this.addNamespaced(types.SearchKind_SEARCH_KIND_K8S_STATEFULSET, cluster.Name, set.Namespace, set.Name)
+	}
+	for _, key := range sortedKeys(cluster.Daemonsets) {
+		set := cluster.Daemonsets[key]
+		this.addNamespaced(types.SearchKind_SEARCH_KIND_K8S_DAEMONSET, cluster.Name, set.Namespace, set.Name)
+	}
+	for _, key := range sortedKeys(cluster.Services) {
+		service := cluster.Services[key]
+		doc := this.addNamespaced(types.SearchKind_SEARCH_KIND_K8S_SERVICE, cluster.Name, service.Namespace, service.Name)
+		doc.field("cluster_ip", service.ClusterIp, 0.9, ipField)
+		doc.field("external_ip", service.ExternalIp, 0.9, ipField)
+	}
+	for _, key := range sortedKeys(cluster.Namespaces) {
+		namespace := cluster.Namespaces[key]
+		this.add(types.SearchKind_SEARCH_KIND_K8S_NAMESPACE, cluster.Name, namespace.Name).field("name", namespace.Name, 1, textField)
+	}
+}
+
+func (this *Index) add(kind types.SearchKind, id, name string) *document {
+	doc := &document{kind: kind, id: id, name: name}
+	this.documents = append(this.documents, doc)
+	return doc
+}
+
+func (this *Index) addNamespaced(kind types.SearchKind, cluster, namespace, name string) *document {
+	doc := this.add(kind, cluster, namespace+"/"+name)
+	doc.field("name", name, 1, textField)
+	doc.field("namespace", namespace, 0.5, textField)
+	return doc
+}
+
+func (this *document) field(name, value string, weight float64, kind fieldKind) {
+	if value == "" {
+		return
+	}
+	f := &field{name: name, value: value, text: strings.ToLower(value), weight: weight, kind: kind}
+	switch kind {
+	case macField:
+		f.text = compactMac(f.text)
+	case textField:
+		f.words = strings.FieldsFunc(f.text, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+	}
+	this.fields = append(this.fields, f)
+}
+
+// Search returns the documents where every word of the query matches a field, ranked by the sum of
+// their best field scores.
+func (this *Index) Search(query *types.SearchQuery) *types.SearchResult {
+	result := &types.SearchResult{Hits: make([]*types.SearchHit, 0)}
+	words := strings.Fields(strings.ToLower(query.Text))
+	if len(words) == 0 {
+		return result
+	}
+	kinds := make(map[types.SearchKind]bool)
+	for _, kind := range query.Kinds {
+		kinds[kind] = true
+	}
+	for _, doc := range this.documents {
+		if len(kinds) > 0 && !kinds[doc.kind] {
+			continue
+		}
+		hit := doc.match(words)
+		if hit != nil {
+			result.Hits = append(result.Hits, hit)
+		}
+	}
+	sort.Slice(result.Hits, func(i, j int) bool {
+		a, b := result.Hits[i], result.Hits[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Id != b.Id {
+			return a.Id < b.Id
+		}
+		return a.Name < b.Name
+	})
+	result.Total = int32(len(result.Hits))
+	limit := int(query.Limit)
+	if limit <= 0 {
+		limit = DEFAULT_SEARCH_LIMIT
+	}
+	if len(result.Hits) > limit {
+		result.Hits = result.Hits[:limit]
+	}
+	return result
+}
+
+func (this *document) match(words []string) *types.SearchHit {
+	var hit *types.SearchHit
+	best := 0.0
+	total := 0.0
+	for _, word := range words {
+		wordBest := 0.0
+		var wordField *field
+		for _, f := range this.fields {
+			score := f.score(word) * f.weight
+			if score > wordBest {
+				wordBest = score
+				wordField = f
+			}
+		}
+		if wordField == nil {
+			return nil
+		}
+		total += wordBest
+		if wordBest > best {
+			best = wordBest
+			hit = &types.SearchHit{Kind: this.kind, Id: this.id, Name: this.name, Field: wordField.name,
+				Value: wordField.value}
+		}
+	}
+	hit.Score = total
+	return hit
+}
+
+func (this *field) score(word string) float64 {
+	if this.kind == macField {
+		word = compactMac(word)
+		if word == "" {
+			return 0
+		}
+	}
+	switch {
+	case this.text == word:
+		return SCORE_EXACT
+	case strings.HasPrefix(this.text, word):
+		return SCORE_PREFIX
+	}
+	for _, w := range this.words {
+		if strings.HasPrefix(w, word) {
+			return SCORE_WORD
+		}
+	}
+	if strings.Contains(this.text, word) {
+		return SCORE_SUBSTRING
+	}
+	if len(word) < MIN_FUZZY_LENGTH || this.kind != textField {
+		return 0
+	}
+	allowed := 1
+	if len(word) >= MIN_TWO_TYPOS_LENGTH {
+		allowed = 2
+	}
+	distance := Distance(word, this.text, allowed)
+	for _, w := range this.words {
+		distance = min(distance, Distance(word, w, allowed))
+	}
+	if distance > allowed {
+		return 0
+	}
+	return SCORE_FUZZY / float64(distance)
+}
+
+// Distance is the edit distance between the strings, or limit+1 once it is known to be more than limit.
+func Distance(a, b string, limit int) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra)-len(rb) > limit || len(rb)-len(ra) > limit {
+		return limit + 1
+	}
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+		prev, curr = curr, prev
+	}
+	return min(prev[len(rb)], limit+1)
+}
+
+// compactMac drops the separators of a mac address, so aa:bb:cc, aa-bb-cc and aabb.cc match alike.
+func compactMac(mac string) string {
+	return strings.NewReplacer(":", "", "-", "", ".", "").Replace(mac)
+}
+
+// sortedKeys returns the keys of the non nil elements of the map, sorted.
+func sortedKeys[V comparable](m map[string]V) []string {
+	var zero V
+	keys := make([]string, 0, len(m))
+	for key, value := range m {
+		if value != zero {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,137 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package search
+
+import (
+	"sync"
+	"time"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	ServiceName    = "Search"
+	ServiceArea    = byte(0)
+	INDEX_INTERVAL = time.Minute
+)
+
+// SearchService periodically indexes the network device and k8s caches and serves the ranked hits
+// of a search text, for the search box and prctl search.
+type SearchService struct {
+	vnic    ifs.IVNic
+	index   *Index
+	mtx     *sync.RWMutex
+	running bool
+}
+
+func Activate(vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&SearchService{}, ServiceName, ServiceArea, false, nil)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ServiceName, ": ", err.Error())
+	}
+}
+
+func (this *SearchService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	this.vnic = vnic
+	this.mtx = &sync.RWMutex{}
+	this.running = true
+	vnic.Resources().Registry().RegisterEnums(types.SearchKind_value)
+	vnic.Resources().Registry().Register(&types.SearchQuery{})
+	vnic.Resources().Registry().Register(&types.SearchResult{})
+	go this.scan()
+	return nil
+}
+
+func (this *SearchService) DeActivate() error {
+	this.running = false
+	return nil
+}
+
+func (this *SearchService) scan() {
+	for this.running {
+		this.reindex()
+		time.Sleep(INDEX_INTERVAL)
+	}
+}
+
+// reindex replaces the index with one of the current caches, a cache that fails to respond is left
+// out until the next scan.
+func (this *SearchService) reindex() {
+	devices, err := common.NetworkDevices(this.vnic)
+	if err != nil {
+		this.vnic.Resources().Logger().Error(ServiceName, " failed to index the network devices: ", err.Error())
+	}
+	clusters, err := common.K8sClusters(this.vnic)
+	if err != nil {
+		this.vnic.Resources().Logger().Error(ServiceName, " failed to index the k8s clusters: ", err.Error())
+	}
+	index := NewIndex(devices, clusters)
+	this.mtx.Lock()
+	this.index = index
+	this.mtx.Unlock()
+}
+
+func (this *SearchService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Post is not supported by " + ServiceName)
+}
+
+func (this *SearchService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Put is not supported by " + ServiceName)
+}
+
+func (this *SearchService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ServiceName)
+}
+
+func (this *SearchService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Delete is not supported by " + ServiceName)
+}
+
+func (this *SearchService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, ok := pb.Element().(*types.SearchQuery)
+	if !ok || query.Text == "" {
+		return object.NewError("Expected a search text")
+	}
+	this.mtx.RLock()
+	index := this.index
+	this.mtx.RUnlock()
+	if index == nil {
+		//Searched before the first scan completed
+		this.reindex()
+		this.mtx.RLock()
+		index = this.index
+		this.mtx.RUnlock()
+	}
+	return object.New(nil, index.Search(query))
+}
+
+func (this *SearchService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *SearchService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *SearchService) WebService() ifs.IWebService {
+	return web.New(ServiceName, ServiceArea, nil, nil, nil, nil, nil, nil, nil, nil,
+		&types.SearchQuery{}, &types.SearchResult{})
+}
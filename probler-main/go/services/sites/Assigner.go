@@ -0,0 +1,134 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sites
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	DEFAULT_SITE_RADIUS_KM = 1.0
+	EARTH_RADIUS_KM        = 6371.0
+)
+
+// Assignment is the site a device was assigned to and how it was assigned.
+type Assignment struct {
+	SiteId string
+	How    types.SiteAssignment
+}
+
+// Assign assigns each device to a site. An explicit device mapping takes precedence over a
+// location name mapping, which takes precedence over the nearest site within its radius.
+// Devices that could not be assigned are left out of the result.
+func Assign(devices []*types.NetworkDevice, sites []*types.Site) map[string]*Assignment {
+	byDevice := make(map[string]string)
+	byLocation := make(map[string]string)
+	for _, site := range sortSites(sites) {
+		for _, id := range site.DeviceIds {
+			if _, ok := byDevice[id]; !ok {
+				byDevice[id] = site.Id
+			}
+		}
+		for _, location := range site.Locations {
+			key := strings.ToLower(strings.TrimSpace(location))
+			if _, ok := byLocation[key]; !ok && key != "" {
+				byLocation[key] = site.Id
+			}
+		}
+	}
+
+	result := make(map[string]*Assignment)
+	for _, device := range devices {
+		if device == nil {
+			continue
+		}
+		siteId, ok := byDevice[device.Id]
+		if ok {
+			result[device.Id] = &Assignment{SiteId: siteId, How: types.SiteAssignment_SITE_ASSIGNMENT_EXPLICIT}
+			continue
+		}
+		info := device.Equipmentinfo
+		if info == nil {
+			continue
+		}
+		siteId, ok = byLocation[strings.ToLower(strings.TrimSpace(info.Location))]
+		if ok {
+			result[device.Id] = &Assignment{SiteId: siteId, How: types.SiteAssignment_SITE_ASSIGNMENT_LOCATION}
+			continue
+		}
+		site := Nearest(info.Latitude, info.Longitude, sites)
+		if site != nil {
+			result[device.Id] = &Assignment{SiteId: site.Id, How: types.SiteAssignment_SITE_ASSIGNMENT_PROXIMITY}
+		}
+	}
+	return result
+}
+
+// Nearest returns the closest site whose radius covers the given coordinates, or nil.
+// A 0,0 coordinate is treated as not set.
+func Nearest(latitude, longitude float64, sites []*types.Site) *types.Site {
+	if latitude == 0 && longitude == 0 {
+		return nil
+	}
+	var nearest *types.Site
+	nearestDistance := math.MaxFloat64
+	for _, site := range sortSites(sites) {
+		if site.Latitude == 0 && site.Longitude == 0 {
+			continue
+		}
+		radius := site.RadiusKm
+		if radius <= 0 {
+			radius = DEFAULT_SITE_RADIUS_KM
+		}
+		distance := DistanceKm(latitude, longitude, site.Latitude, site.Longitude)
+		if distance <= radius && distance < nearestDistance {
+			nearest = site
+			nearestDistance = distance
+		}
+	}
+	return nearest
+}
+
+// DistanceKm is the great circle distance between two coordinates, using the haversine formula.
+func DistanceKm(lat1, lon1, lat2, lon2 float64) float64 {
+	dLat := toRadians(lat2 - lat1)
+	dLon := toRadians(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return EARTH_RADIUS_KM * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+// sortSites returns the non nil sites ordered by Id, so overlapping mappings resolve the same way every time.
+func sortSites(sites []*types.Site) []*types.Site {
+	sorted := make([]*types.Site, 0, len(sites))
+	for _, site := range sites {
+		if site != nil {
+			sorted = append(sorted, site)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Id < sorted[j].Id
+	})
+	return sorted
+}
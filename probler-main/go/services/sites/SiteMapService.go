@@ -0,0 +1,89 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sites
+
+import (
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	MapServiceName = "SiteMap"
+	MapServiceArea = byte(0)
+)
+
+// SiteMapService assigns the devices in the network device cache to the sites and serves
+// the per site summary shown on the map view.
+type SiteMapService struct {
+	vnic ifs.IVNic
+}
+
+func (this *SiteMapService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	this.vnic = vnic
+	vnic.Resources().Registry().Register(&types.SiteSummaryQuery{})
+	vnic.Resources().Registry().Register(&types.SiteSummaryList{})
+	return nil
+}
+
+func (this *SiteMapService) DeActivate() error {
+	this.vnic = nil
+	return nil
+}
+
+func (this *SiteMapService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Post is not supported by " + MapServiceName)
+}
+
+func (this *SiteMapService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Put is not supported by " + MapServiceName)
+}
+
+func (this *SiteMapService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + MapServiceName)
+}
+
+func (this *SiteMapService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Delete is not supported by " + MapServiceName)
+}
+
+func (this *SiteMapService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	query, _ := pb.Element().(*types.SiteSummaryQuery)
+	sites, err := Sites(this.vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	devices, err := common.NetworkDevices(this.vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, Summarize(devices, sites, query))
+}
+
+func (this *SiteMapService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *SiteMapService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *SiteMapService) WebService() ifs.IWebService {
+	return web.New(MapServiceName, MapServiceArea, nil, nil, nil, nil, nil, nil, nil, nil,
+		&types.SiteSummaryQuery{}, &types.SiteSummaryList{})
+}
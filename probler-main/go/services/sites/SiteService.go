@@ -0,0 +1,159 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sites
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/types"
+)
+
+const (
+	ServiceName = "Sites"
+	ServiceArea = byte(0)
+)
+
+// SiteService is the inventory of sites, with their buildings and rooms, keyed by the site Id, in
+// the orm database so they survive a restart.
+type SiteService struct {
+	table *persist.Table
+}
+
+// Activate activates both the site inventory service and the site map service.
+func Activate(db *sql.DB, vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&SiteService{}, ServiceName, ServiceArea, false, nil)
+	sla.SetArgs(db)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ServiceName, ": ", err.Error())
+	}
+	sla = ifs.NewServiceLevelAgreement(&SiteMapService{}, MapServiceName, MapServiceArea, false, nil)
+	_, err = vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", MapServiceName, ": ", err.Error())
+	}
+}
+
+// Sites fetches all the sites from the site inventory service.
+func Sites(vnic ifs.IVNic) ([]*types.Site, error) {
+	resp := vnic.Request("", ServiceName, ServiceArea, ifs.GET, &types.Site{}, common.INVENTORY_REQUEST_TIMEOUT)
+	if resp == nil {
+		return nil, errors.New("No response from " + ServiceName)
+	}
+	if resp.Error() != nil {
+		return nil, resp.Error()
+	}
+	list, ok := resp.Element().(*types.SiteList)
+	if !ok {
+		return nil, errors.New("Unexpected response type from " + ServiceName)
+	}
+	return list.List, nil
+}
+
+func (this *SiteService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	table, err := persist.NewTable(sla.Args()[0].(*sql.DB), &types.Site{})
+	if err != nil {
+		return err
+	}
+	this.table = table
+	vnic.Resources().Registry().Register(&types.Site{})
+	vnic.Resources().Registry().Register(&types.SiteList{})
+	return nil
+}
+
+func (this *SiteService) DeActivate() error {
+	this.table = nil
+	return nil
+}
+
+func (this *SiteService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	site, ok := pb.Element().(*types.Site)
+	if !ok || site.Id == "" {
+		return object.NewError("Expected a site with an Id")
+	}
+	err := this.table.Save(site.Id, site)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, site)
+}
+
+func (this *SiteService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.Post(pb, vnic)
+}
+
+func (this *SiteService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ServiceName)
+}
+
+func (this *SiteService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	site, ok := pb.Element().(*types.Site)
+	if !ok || site.Id == "" {
+		return object.NewError("Expected a site with an Id")
+	}
+	err := this.table.Delete(site.Id)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, site)
+}
+
+// Get returns the site with the given Id, or all the sites when the Id is empty.
+func (this *SiteService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	list := &types.SiteList{List: make([]*types.Site, 0)}
+	site, ok := pb.Element().(*types.Site)
+	if ok && site.Id != "" {
+		elem, err := this.table.Load(site.Id)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		if elem != nil {
+			list.List = append(list.List, elem.(*types.Site))
+		}
+		return object.New(nil, list)
+	}
+	elems, err := this.table.LoadAll()
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	for _, elem := range elems {
+		list.List = append(list.List, elem.(*types.Site))
+	}
+	return object.New(nil, list)
+}
+
+func (this *SiteService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *SiteService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *SiteService) WebService() ifs.IWebService {
+	return web.New(ServiceName, ServiceArea,
+		&types.Site{}, &types.Site{},
+		&types.Site{}, &types.Site{},
+		nil, nil,
+		&types.Site{}, &types.Site{},
+		&types.Site{}, &types.SiteList{})
+}
@@ -0,0 +1,92 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sites
+
+import (
+	"sort"
+
+	"github.com/saichler/probler/go/types"
+)
+
+// Summarize builds the per site device counts, online/offline totals and alarm severity for the map view.
+func Summarize(devices []*types.NetworkDevice, sites []*types.Site, query *types.SiteSummaryQuery) *types.SiteSummaryList {
+	assignments := Assign(devices, sites)
+	summaries := make(map[string]*types.SiteSummary)
+	result := &types.SiteSummaryList{List: make([]*types.SiteSummary, 0, len(sites)),
+		UnassignedDeviceIds: make([]string, 0)}
+	for _, site := range sortSites(sites) {
+		if query != nil && query.SiteId != "" && query.SiteId != site.Id {
+			continue
+		}
+		summary := &types.SiteSummary{SiteId: site.Id, Name: site.Name,
+			Latitude: site.Latitude, Longitude: site.Longitude, Devices: make([]*types.SiteDevice, 0)}
+		summaries[site.Id] = summary
+		result.List = append(result.List, summary)
+	}
+
+	sorted := make([]*types.NetworkDevice, 0, len(devices))
+	for _, device := range devices {
+		if device != nil {
+			sorted = append(sorted, device)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Id < sorted[j].Id
+	})
+
+	for _, device := range sorted {
+		assignment, ok := assignments[device.Id]
+		if !ok {
+			result.UnassignedDeviceIds = append(result.UnassignedDeviceIds, device.Id)
+			continue
+		}
+		summary, ok := summaries[assignment.SiteId]
+		if !ok {
+			continue
+		}
+		summary.DeviceCount++
+		summary.Devices = append(summary.Devices, &types.SiteDevice{DeviceId: device.Id, Assignment: assignment.How})
+		status := types.DeviceStatus_DEVICE_STATUS_UNKNOWN
+		if device.Equipmentinfo != nil {
+			status = device.Equipmentinfo.DeviceStatus
+		}
+		switch status {
+		case types.DeviceStatus_DEVICE_STATUS_UNKNOWN:
+		case types.DeviceStatus_DEVICE_STATUS_OFFLINE:
+			summary.OfflineCount++
+		default:
+			summary.OnlineCount++
+		}
+		severity := Severity(status)
+		if severity > summary.AlarmSeverity {
+			summary.AlarmSeverity = severity
+		}
+	}
+	return result
+}
+
+// Severity maps a device status to the alarm severity shown on the map.
+func Severity(status types.DeviceStatus) types.AlarmSeverity {
+	switch status {
+	case types.DeviceStatus_DEVICE_STATUS_CRITICAL:
+		return types.AlarmSeverity_ALARM_SEVERITY_CRITICAL
+	case types.DeviceStatus_DEVICE_STATUS_OFFLINE:
+		return types.AlarmSeverity_ALARM_SEVERITY_MAJOR
+	case types.DeviceStatus_DEVICE_STATUS_WARNING, types.DeviceStatus_DEVICE_STATUS_PARTIAL:
+		return types.AlarmSeverity_ALARM_SEVERITY_WARNING
+	}
+	return types.AlarmSeverity_ALARM_SEVERITY_NONE
+}
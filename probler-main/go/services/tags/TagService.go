@@ -0,0 +1,233 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tags
+
+import (
+	"database/sql"
+	"errors"
+	"sort"
+
+	"github.com/saichler/l8pollaris/go/pollaris/targets"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8types/go/types/l8api"
+	"github.com/saichler/l8utils/go/utils/web"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/groups"
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	ServiceName = "Tags"
+	ServiceArea = byte(0)
+)
+
+// TagService holds the user defined tags of the targets and of the network devices polled from them.
+// The tags are stored apart from the polled data and set on every network device the persist service
+// saves, so the polls never overwrite them. A TagUpdate is merged with a Post, replaces the tags with a
+// Put and removes them with a Delete.
+type TagService struct {
+	store *persist.TagStore
+	vnic  ifs.IVNic
+}
+
+func Activate(db *sql.DB, vnic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&TagService{}, ServiceName, ServiceArea, false, nil)
+	sla.SetArgs(db)
+	_, err := vnic.Resources().Services().Activate(sla, vnic)
+	if err != nil {
+		vnic.Resources().Logger().Error("Failed to activate ", ServiceName, ": ", err.Error())
+	}
+}
+
+func (this *TagService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	this.store = persist.NewTagStore(sla.Args()[0].(*sql.DB))
+	this.vnic = vnic
+	vnic.Resources().Registry().Register(&types.TagSet{})
+	vnic.Resources().Registry().Register(&types.TagSetList{})
+	vnic.Resources().Registry().Register(&types.TagUpdate{})
+	persist.AddDecorator(common.NetworkDevice_Links_ID, this.decorate)
+	return nil
+}
+
+func (this *TagService) DeActivate() error {
+	this.vnic = nil
+	return nil
+}
+
+// decorate sets the stored tags on a network device about to be saved.
+func (this *TagService) decorate(key string, elem proto.Message) {
+	device, ok := elem.(*types.NetworkDevice)
+	if !ok {
+		return
+	}
+	tags, err := this.store.Tags(key)
+	if err != nil {
+		this.vnic.Resources().Logger().Error(ServiceName, " failed to load the tags of ", key, ": ", err.Error())
+		return
+	}
+	device.Tags = tags
+}
+
+func (this *TagService) Post(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.update(pb, false, false)
+}
+
+func (this *TagService) Put(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.update(pb, true, false)
+}
+
+func (this *TagService) Patch(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("Patch is not supported by " + ServiceName)
+}
+
+func (this *TagService) Delete(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return this.update(pb, false, true)
+}
+
+func (this *TagService) update(pb ifs.IElements, replace, remove bool) ifs.IElements {
+	update, ok := pb.Element().(*types.TagUpdate)
+	if !ok {
+		return object.NewError("Expected a tag update")
+	}
+	ids, err := this.ids(update)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	set := update.Set
+	if remove {
+		set = nil
+		replace = len(update.Remove) == 0
+	}
+	err = persist.ValidateTags(set, update.Remove)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	err = this.store.Update(ids, set, update.Remove, replace)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	list := &types.TagSetList{List: make([]*types.TagSet, 0, len(ids))}
+	for _, id := range ids {
+		tags, err := this.store.Tags(id)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		list.List = append(list.List, &types.TagSet{Id: id, Tags: tags})
+		this.cache(id, tags)
+	}
+	return object.New(nil, list)
+}
+
+// ids returns the listed ids and the ids of the group members, without duplicates.
+func (this *TagService) ids(update *types.TagUpdate) ([]string, error) {
+	ids := make([]string, 0, len(update.Ids))
+	seen := make(map[string]bool)
+	for _, id := range update.Ids {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if update.Group != "" {
+		members, err := groups.Members(update.Group, this.vnic)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range sortedIds(members) {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return nil, errors.New("Expected the ids, or a group with members, to tag")
+	}
+	return ids, nil
+}
+
+// cache sets the tags on the cached network device, so the cache queries see them before the next save.
+func (this *TagService) cache(id string, tags map[string]string) {
+	cached, err := persist.Cached(common.NetworkDevice_Links_ID, &types.NetworkDevice{}, "Id", id, this.vnic)
+	if err != nil || len(cached) == 0 {
+		//Only a target so far, the tags are set when its device is first saved
+		return
+	}
+	device, ok := cached[0].(*types.NetworkDevice)
+	if !ok {
+		return
+	}
+	device.Tags = tags
+	name, area := targets.Links.Cache(common.NetworkDevice_Links_ID)
+	resp := this.vnic.Request("", name, area, ifs.PUT, device, common.INVENTORY_REQUEST_TIMEOUT)
+	if resp != nil && resp.Error() != nil {
+		this.vnic.Resources().Logger().Error(ServiceName, " failed to tag ", id, " in ", name, ": ", resp.Error().Error())
+	}
+}
+
+// Get returns the tags of a tag set's id, or the tag sets matching the tag clauses of a query, e.g.
+// "select * from TagSet where tag.env=prod", or all the tag sets.
+func (this *TagService) Get(pb ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	var sets []*types.TagSet
+	var err error
+	switch query := pb.Element().(type) {
+	case *types.TagSet:
+		if query.Id == "" {
+			sets, err = this.store.Find(nil)
+			break
+		}
+		var tags map[string]string
+		tags, err = this.store.Tags(query.Id)
+		sets = []*types.TagSet{{Id: query.Id, Tags: tags}}
+	case *l8api.L8Query:
+		sets, err = this.store.Find(persist.TagFilters(query.Text))
+	default:
+		sets, err = this.store.Find(nil)
+	}
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, &types.TagSetList{List: sets})
+}
+
+func (this *TagService) Failed(pb ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *TagService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *TagService) WebService() ifs.IWebService {
+	return web.New(ServiceName, ServiceArea,
+		&types.TagUpdate{}, &types.TagSetList{},
+		&types.TagUpdate{}, &types.TagSetList{},
+		nil, nil,
+		&types.TagUpdate{}, &types.TagSetList{},
+		&l8api.L8Query{}, &types.TagSetList{})
+}
+
+func sortedIds(ids map[string]bool) []string {
+	result := make([]string, 0, len(ids))
+	for id := range ids {
+		result = append(result, id)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,91 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/saichler/probler/go/services/assets"
+	"github.com/saichler/probler/go/types"
+)
+
+func assetDevice(id, serial string, psSerials ...string) *types.NetworkDevice {
+	chassis := &types.Chassis{SerialNumber: serial + "-CH"}
+	for _, s := range psSerials {
+		chassis.PowerSupplies = append(chassis.PowerSupplies, &types.PowerSupply{SerialNumber: s})
+	}
+	return &types.NetworkDevice{Id: id,
+		Equipmentinfo: &types.EquipmentInfo{SerialNumber: serial, Vendor: "Cisco"},
+		Physicals:     map[string]*types.Physical{"1": {Id: "1", Chassis: []*types.Chassis{chassis}}}}
+}
+
+func lastEvent(asset *types.Asset) types.AssetEventType {
+	return asset.History[len(asset.History)-1].EventType
+}
+
+func TestAssetRegister(t *testing.T) {
+	register := assets.NewRegister()
+	stored := register.Update([]*types.NetworkDevice{assetDevice("r1", "SN1", "PS1"), assetDevice("r2", "SN2")}, 100)
+
+	list := register.List(nil)
+	if len(list.List) != 5 || len(stored) != 5 {
+		t.Fatalf("Expected 5 assets, got %d and %d changed", len(list.List), len(stored))
+	}
+
+	//A restarted register loaded with the stored assets does not see them appear again
+	restarted := assets.NewRegister()
+	restarted.Load(stored)
+	restarted.Update([]*types.NetworkDevice{assetDevice("r1", "SN1", "PS1"), assetDevice("r2", "SN2")}, 150)
+	for _, asset := range restarted.List(nil).List {
+		if len(asset.History) != 1 || asset.FirstSeen != 100 || asset.LastSeen != 150 {
+			t.Fatalf("Expected %s to be carried over from the stored register, got %v", asset.SerialNumber, asset)
+		}
+	}
+
+	//PS1 is replaced by PS9 in r1
+	register.Update([]*types.NetworkDevice{assetDevice("r1", "SN1", "PS9"), assetDevice("r2", "SN2")}, 200)
+	missing := register.List(&types.AssetQuery{State: types.AssetState_ASSET_STATE_MISSING})
+	if len(missing.List) != 1 || missing.List[0].SerialNumber != "PS1" {
+		t.Fatalf("Expected PS1 to be missing, got %v", missing.List)
+	}
+	event := missing.List[0].History[1]
+	if event.EventType != types.AssetEventType_ASSET_EVENT_REPLACED || event.ReplacedBy != "PS9" {
+		t.Fatalf("Expected PS1 to be replaced by PS9, got %v", event)
+	}
+
+	//PS1 is re-installed in r2
+	register.Update([]*types.NetworkDevice{assetDevice("r1", "SN1", "PS9"), assetDevice("r2", "SN2", "PS1")}, 300)
+	moved := register.List(&types.AssetQuery{DeviceId: "r2"})
+	if len(moved.List) != 3 {
+		t.Fatalf("Expected 3 assets on r2, got %d", len(moved.List))
+	}
+	for _, asset := range moved.List {
+		if asset.SerialNumber != "PS1" {
+			continue
+		}
+		if asset.State != types.AssetState_ASSET_STATE_PRESENT || lastEvent(asset) != types.AssetEventType_ASSET_EVENT_MOVED {
+			t.Fatalf("Expected PS1 to be present and moved, got %v", asset)
+		}
+		if asset.FirstSeen != 100 || asset.LastSeen != 300 {
+			t.Fatalf("Unexpected first/last seen %d/%d", asset.FirstSeen, asset.LastSeen)
+		}
+	}
+
+	data, err := assets.ToCSV(register.List(nil))
+	if err != nil || len(data) == 0 {
+		t.Fatalf("Failed to export the register to csv: %v", err)
+	}
+}
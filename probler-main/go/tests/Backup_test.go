@@ -0,0 +1,114 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/saichler/probler/go/prob/common/backup"
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestBackupArchive(t *testing.T) {
+	if _, err := backup.NewArchive("xml"); err == nil {
+		t.Fatal("Expected an unknown format error")
+	}
+	devices := &types.NetworkDeviceList{List: []*types.NetworkDevice{
+		{Id: "10.1.1.1", Equipmentinfo: &types.EquipmentInfo{SerialNumber: "SN1"}}, {Id: "10.1.1.2"}}}
+	clusters := &types.K8SClusterList{List: []*types.K8SCluster{{Name: "lab"}}}
+	for _, format := range []string{backup.FORMAT_PROTOBUF, backup.FORMAT_JSON} {
+		archive, err := backup.NewArchive(format)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if backup.AddSection(archive, devices) != nil || backup.AddSection(archive, clusters) != nil {
+			t.Fatal("Failed to add the sections")
+		}
+		if len(archive.Sections) != 2 || archive.Sections[0].Type != "NetworkDeviceList" || archive.Sections[0].Count != 2 {
+			t.Fatalf("Unexpected sections %v", archive.Sections)
+		}
+		data, err := backup.Write(archive)
+		if err != nil {
+			t.Fatal(err)
+		}
+		read, err := backup.Read(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		restored := &types.NetworkDeviceList{}
+		if ok, err := backup.Section(read, restored); !ok || err != nil || !proto.Equal(restored, devices) {
+			t.Fatalf("Unexpected %s devices %v %v", format, restored, err)
+		}
+		if ok, _ := backup.Section(read, &types.PowerDeviceList{}); ok {
+			t.Fatal("Expected no power device section")
+		}
+	}
+
+	newer := &types.BackupArchive{Version: backup.VERSION + 1, Format: backup.FORMAT_PROTOBUF}
+	data, _ := backup.Write(newer)
+	if _, err := backup.Read(data); err == nil {
+		t.Fatal("Expected a newer version to be refused")
+	}
+	if _, err := backup.Read([]byte("not an archive")); err == nil {
+		t.Fatal("Expected an invalid archive error")
+	}
+
+	//A reference to a secret is not a secret
+	volume := &types.Volume{Name: "creds", Secret: &types.SecretVolumeSource{SecretName: "db-creds"}}
+	if backup.Scrub(volume) != 0 || volume.Secret.SecretName != "db-creds" {
+		t.Fatal("Expected the secret reference to be kept")
+	}
+}
+
+// secretHost returns a message with inline secrets, e.g. of a target host, and its descriptor.
+func secretHost(t *testing.T) (protoreflect.MessageDescriptor, *dynamicpb.Message) {
+	str := descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{Name: proto.String("host.proto"),
+		Package: proto.String("tests"), Syntax: proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{Name: proto.String("Host"), Field: []*descriptorpb.FieldDescriptorProto{
+			{Name: proto.String("address"), JsonName: proto.String("address"), Number: proto.Int32(1), Type: str},
+			{Name: proto.String("snmp_community"), JsonName: proto.String("snmpCommunity"), Number: proto.Int32(2), Type: str},
+			{Name: proto.String("password"), JsonName: proto.String("password"), Number: proto.Int32(3), Type: str},
+		}}}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	descriptor := file.Messages().Get(0)
+	host := dynamicpb.NewMessage(descriptor)
+	host.Set(descriptor.Fields().ByName("address"), protoreflect.ValueOfString("10.1.1.1"))
+	host.Set(descriptor.Fields().ByName("snmp_community"), protoreflect.ValueOfString("private"))
+	host.Set(descriptor.Fields().ByName("password"), protoreflect.ValueOfString("admin"))
+	return descriptor, host
+}
+
+func TestBackupSecrets(t *testing.T) {
+	descriptor, stored := secretHost(t)
+	archived := proto.Clone(stored)
+	if backup.Scrub(archived) != 2 || proto.Equal(archived, stored) {
+		t.Fatal("Expected the community and the password to be cleared")
+	}
+	if archived.ProtoReflect().Get(descriptor.Fields().ByName("address")).String() != "10.1.1.1" {
+		t.Fatal("Expected the address to be kept")
+	}
+	if backup.Unscrub(archived, stored) != 2 || !proto.Equal(archived, stored) {
+		t.Fatal("Expected restoring an unchanged element to keep its secrets and change nothing")
+	}
+}
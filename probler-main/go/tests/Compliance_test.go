@@ -0,0 +1,59 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/saichler/probler/go/services/compliance"
+	"github.com/saichler/probler/go/types"
+)
+
+func complianceDevice(id, vendor, model, version, location string) *types.NetworkDevice {
+	return &types.NetworkDevice{Id: id, Equipmentinfo: &types.EquipmentInfo{Vendor: vendor, Model: model,
+		Version: version, Location: location}}
+}
+
+func TestCompliance(t *testing.T) {
+	policies := []*types.VersionPolicy{
+		{Name: "cisco", Vendor: "Cisco", ApprovedVersions: []string{"17.3.1"}, TargetVersion: "17.6.1"},
+		{Name: "cisco-asr", Vendor: "Cisco", Model: "ASR9K", ApprovedVersions: []string{"7.5.2"}},
+	}
+	devices := []*types.NetworkDevice{
+		complianceDevice("d1", "Cisco", "C9300", "17.6.1", "NY DC1"),
+		complianceDevice("d2", "cisco", "C9300", "17.3.1", "NY DC1"),
+		complianceDevice("d3", "Cisco", "C9300", "16.9.4", "LA DC2"),
+		complianceDevice("d4", "Cisco", "ASR9K", "7.1.1", "NY DC1"),
+		complianceDevice("d5", "Juniper", "MX960", "21.4R1", "NY DC1"),
+	}
+
+	report := compliance.Classify(devices, policies, nil)
+	if report.CompliantCount != 2 || report.UpgradeNeededCount != 1 || report.UnsupportedCount != 2 {
+		t.Fatalf("Unexpected counts %d/%d/%d", report.CompliantCount, report.UpgradeNeededCount, report.UnsupportedCount)
+	}
+	if report.Devices[3].Policy != "cisco-asr" {
+		t.Fatalf("Expected the model specific policy to be applied, got %s", report.Devices[3].Policy)
+	}
+	if report.Devices[2].Status != types.ComplianceStatus_COMPLIANCE_STATUS_UPGRADE_NEEDED ||
+		report.Devices[2].TargetVersion != "17.6.1" {
+		t.Fatalf("Expected d3 to need an upgrade to 17.6.1, got %v", report.Devices[2])
+	}
+
+	report = compliance.Classify(devices, policies, &types.ComplianceQuery{Location: "la dc"})
+	if len(report.Devices) != 1 || report.Devices[0].DeviceId != "d3" {
+		t.Fatalf("Expected only d3 in the LA report, got %v", report.Devices)
+	}
+}
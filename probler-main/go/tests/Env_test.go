@@ -0,0 +1,65 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/saichler/probler/go/services/environment"
+	"github.com/saichler/probler/go/types"
+)
+
+func TestEnvHeatMap(t *testing.T) {
+	device := &types.EnvDevice{Id: "10.30.0.5", Sensors: map[string]*types.EnvSensor{
+		"1": {Name: "Inlet", SensorType: types.EnvSensorType_ENV_SENSOR_TYPE_CELSIUS,
+			Scale: types.EnvSensorScale_ENV_SENSOR_SCALE_UNITS, Precision: 1, Value: 295},
+		"2": {Name: "Humidity", SensorType: types.EnvSensorType_ENV_SENSOR_TYPE_PERCENT_RH,
+			Scale: types.EnvSensorScale_ENV_SENSOR_SCALE_UNITS, Value: 45},
+		"3": {Name: "Floor", SensorType: types.EnvSensorType_ENV_SENSOR_TYPE_TRUTH_VALUE, Value: 1},
+		"4": {Name: "Fan", SensorType: types.EnvSensorType_ENV_SENSOR_TYPE_RPM, Value: 3000},
+		"5": {Name: "Outlet", SensorType: types.EnvSensorType_ENV_SENSOR_TYPE_CELSIUS, Value: 30},
+		"6": {Name: "Broken", SensorType: types.EnvSensorType_ENV_SENSOR_TYPE_CELSIUS, Value: 99,
+			Status: types.EnvSensorStatus_ENV_SENSOR_STATUS_NONOPERATIONAL}}}
+	bindings := []*types.EnvSensorBinding{
+		{DeviceId: device.Id, SensorId: "1", RackId: "r1"},
+		{DeviceId: device.Id, SensorId: "2", RackId: "r1"},
+		{DeviceId: device.Id, SensorId: "3", RoomId: "room1"},
+		{DeviceId: device.Id, SensorId: "6", RackId: "r1"}}
+	locations := environment.NewLocations([]*types.Rack{{Id: "r1", Name: "Rack 1", RowId: "row1"}},
+		[]*types.RackRow{{Id: "row1", RoomId: "room1"}}, nil)
+
+	heatMap := environment.Build([]*types.EnvDevice{device}, bindings, locations, nil)
+	if heatMap.UnboundSensors != 1 || len(heatMap.Locations) != 2 {
+		t.Fatalf("Expected 2 locations and 1 unbound sensor, got %d/%d", len(heatMap.Locations), heatMap.UnboundSensors)
+	}
+	rack := heatMap.Locations[0]
+	if rack.Name != "Rack 1" || rack.RoomId != "room1" || rack.MaxTemperature != 29.5 || rack.AvgHumidity != 45 {
+		t.Fatalf("Unexpected rack location %v", rack)
+	}
+	if !heatMap.Locations[1].Leak || len(heatMap.Breaches) != 2 {
+		t.Fatalf("Expected the leak and the inlet temperature to breach, got %v", heatMap.Breaches)
+	}
+	if heatMap.Breaches[0].Breach != types.EnvBreach_ENV_BREACH_HIGH || heatMap.Breaches[1].Breach != types.EnvBreach_ENV_BREACH_LEAK {
+		t.Fatalf("Unexpected breaches %v", heatMap.Breaches)
+	}
+
+	bindings[0].Threshold = &types.EnvThreshold{Low: 15, High: 32}
+	heatMap = environment.Build([]*types.EnvDevice{device}, bindings, locations,
+		&types.HeatMapQuery{RackId: "r1", Kind: types.EnvSensorKind_ENV_SENSOR_KIND_TEMPERATURE})
+	if len(heatMap.Locations) != 1 || len(heatMap.Locations[0].Readings) != 1 || len(heatMap.Breaches) != 0 {
+		t.Fatalf("Expected a single temperature reading within its threshold, got %v", heatMap)
+	}
+}
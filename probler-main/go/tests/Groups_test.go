@@ -0,0 +1,145 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/saichler/l8pollaris/go/pollaris/targets"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/conditions"
+	"github.com/saichler/probler/go/services/groups"
+	"github.com/saichler/probler/go/services/sites"
+	"github.com/saichler/probler/go/types"
+)
+
+func groupDevice(id, vendor, location string, bgp bool, mtu uint32) *types.NetworkDevice {
+	return &types.NetworkDevice{Id: id, Equipmentinfo: &types.EquipmentInfo{Vendor: vendor, Location: location},
+		Logicals: map[string]*types.Logical{"0": {Id: "0", Interfaces: []*types.Interface{
+			{Id: "eth0", Mtu: 1500},
+			{Id: "eth1", Mtu: mtu, BgpInfo: &types.BgpInfo{BgpEnabled: bgp}},
+		}}}}
+}
+
+func groupIds(t *testing.T, query *types.SavedQuery, devices []*types.NetworkDevice, allSites []*types.Site) []string {
+	members, err := groups.Evaluate(query, devices, nil, sites.Assign(devices, allSites))
+	if err != nil {
+		t.Fatalf("Failed to evaluate %s: %s", query.Name, err.Error())
+	}
+	return members.Ids
+}
+
+func TestGroups(t *testing.T) {
+	devices := []*types.NetworkDevice{
+		groupDevice("d1", "Cisco", "NY DC1", true, 9000),
+		groupDevice("d2", "cisco", "NY DC1", false, 1500),
+		groupDevice("d3", "Juniper", "LA DC2", true, 1500),
+	}
+	allSites := []*types.Site{{Id: "ny", Locations: []string{"ny dc1"}}}
+	target := types.GroupTarget_GROUP_TARGET_NETWORK_DEVICE
+
+	bgp := &types.SavedQuery{Name: "bgp", Target: target, Conditions: []*types.QueryCondition{
+		{Field: "logicals.*.interfaces.*.bgp_info.bgp_enabled", Operator: types.ConditionOperator_CONDITION_EQUALS, Value: "true"}}}
+	ids := groupIds(t, bgp, devices, allSites)
+	if len(ids) != 2 || ids[0] != "d1" || ids[1] != "d3" {
+		t.Fatalf("Expected d1 and d3 to run bgp, got %v", ids)
+	}
+
+	bgp.SiteId = "ny"
+	ids = groupIds(t, bgp, devices, allSites)
+	if len(ids) != 1 || ids[0] != "d1" {
+		t.Fatalf("Expected only d1 to run bgp in ny, got %v", ids)
+	}
+
+	cisco := &types.SavedQuery{Name: "cisco", Target: target, ExcludeIds: []string{"d1"}, IncludeIds: []string{"d3"},
+		Conditions: []*types.QueryCondition{
+			{Field: "equipmentinfo.vendor", Operator: types.ConditionOperator_CONDITION_EQUALS, Value: "CISCO"}}}
+	ids = groupIds(t, cisco, devices, allSites)
+	if len(ids) != 2 || ids[0] != "d2" || ids[1] != "d3" {
+		t.Fatalf("Expected the include and exclude ids to override the conditions, got %v", ids)
+	}
+
+	jumbo := &types.SavedQuery{Name: "jumbo", Target: target, Conditions: []*types.QueryCondition{
+		{Field: "logicals.interfaces.mtu", Operator: types.ConditionOperator_CONDITION_GREATER, Value: "1500"},
+		{Field: "equipmentinfo.location", Operator: types.ConditionOperator_CONDITION_MATCHES, Value: "^ny"}}}
+	ids = groupIds(t, jumbo, devices, allSites)
+	if len(ids) != 1 || ids[0] != "d1" {
+		t.Fatalf("Expected only d1 to have jumbo frames, got %v", ids)
+	}
+
+	clusters := []*types.K8SCluster{
+		{Name: "lab", Nodes: map[string]*types.K8SNode{"n1": {Name: "n1", Version: "v1.28.3"}}},
+		{Name: "prod", Nodes: map[string]*types.K8SNode{"n1": {Name: "n1", Version: "v1.30.1"}}},
+	}
+	old := &types.SavedQuery{Name: "old", Target: types.GroupTarget_GROUP_TARGET_K8S_CLUSTER,
+		Conditions: []*types.QueryCondition{
+			{Field: "nodes.version", Operator: types.ConditionOperator_CONDITION_PREFIX, Value: "v1.28"}}}
+	members, err := groups.Evaluate(old, nil, clusters, nil)
+	if err != nil || len(members.Clusters) != 1 || members.Clusters[0].Name != "lab" {
+		t.Fatalf("Expected only the lab cluster to run an old version, got %v %v", members, err)
+	}
+
+	invalid := []*types.SavedQuery{
+		{Name: "no-target", Conditions: old.Conditions},
+		{Name: "no-field", Target: target, Conditions: []*types.QueryCondition{
+			{Field: "equipmentinfo.colour", Operator: types.ConditionOperator_CONDITION_EXISTS}}},
+		{Name: "not-a-value", Target: target, Conditions: []*types.QueryCondition{
+			{Field: "equipmentinfo", Operator: types.ConditionOperator_CONDITION_EXISTS}}},
+		{Name: "not-a-number", Target: target, Conditions: []*types.QueryCondition{
+			{Field: "logicals.interfaces.mtu", Operator: types.ConditionOperator_CONDITION_LESS, Value: "big"}}},
+		{Name: "cluster-site", Target: types.GroupTarget_GROUP_TARGET_K8S_CLUSTER, SiteId: "ny"},
+	}
+	for _, query := range invalid {
+		if _, err := groups.Compile(query); err == nil {
+			t.Fatalf("Expected %s to be rejected", query.Name)
+		}
+	}
+}
+
+func TestGroupClause(t *testing.T) {
+	descriptor := (&types.NetworkDevice{}).ProtoReflect().Descriptor()
+	text := "select * from NetworkDevice where group=bgp and equipmentinfo.vendor=cisco and group='ny routers'"
+	where, err := conditions.Where(descriptor, text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(where) != 1 {
+		t.Fatalf("Expected the group terms to be skipped, got %d conditions", len(where))
+	}
+	names := conditions.Groups(text)
+	if len(names) != 2 || names[0] != "bgp" || names[1] != "ny routers" {
+		t.Fatalf("Expected the bgp and ny routers groups, got %v", names)
+	}
+	if names = conditions.Groups("select * from NetworkDevice where tag.group=lab"); len(names) != 0 {
+		t.Fatalf("Expected a tag not to be a group, got %v", names)
+	}
+	for _, text := range []string{
+		"select * from NetworkDevice where group!=bgp",
+		"select * from NetworkDevice where group=bgp*",
+		"select * from NetworkDevice where group="} {
+		if _, err = conditions.Where(descriptor, text); err == nil {
+			t.Fatalf("Expected '%s' not to be applied", text)
+		}
+	}
+
+	targets.Links = &common.Links{}
+	if name, _ := common.QueryService(common.NetworkDevice_Links_ID, "select * from NetworkDevice where group=bgp"); name != common.NetDev_Persist_Service_Name {
+		t.Fatalf("Expected the persist service to serve the group query, got %s", name)
+	}
+	if name, _ := common.QueryService(common.K8s_Links_ID, "select * from K8SCluster where Name=group"); name != common.K8s_Cache_Service_Name {
+		t.Fatalf("Expected the cache to serve the cluster query, got %s", name)
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestInventoryChanges(t *testing.T) {
+	old := &types.NetworkDevice{Id: "10.1.1.1",
+		Equipmentinfo: &types.EquipmentInfo{SerialNumber: "SN1", Software: "IOS-XE 17.1", Uptime: "1d"},
+		Physicals: map[string]*types.Physical{"chassis": {Id: "chassis",
+			Chassis: []*types.Chassis{{Id: "1", Modules: []*types.Module{{Id: "m1", Name: "Line card 1"}}, Temperature: 40}},
+			Ports: []*types.Port{{Id: "Gi0/1", Interfaces: []*types.Interface{{Id: "Gi0/1", Description: "uplink",
+				Statistics: &types.InterfaceStatistics{RxPackets: 100}}}}}}}}
+	new := proto.Clone(old).(*types.NetworkDevice)
+	new.Equipmentinfo.SerialNumber = "SN2"
+	new.Equipmentinfo.Software = "IOS-XE 17.2"
+	new.Equipmentinfo.Uptime = "2d"
+	chassis := new.Physicals["chassis"].Chassis[0]
+	chassis.Modules = append(chassis.Modules, &types.Module{Id: "m2", Name: "Line card 2"})
+	chassis.Temperature = 45
+	iface := new.Physicals["chassis"].Ports[0].Interfaces[0]
+	iface.Description = "uplink to core"
+	iface.Statistics.RxPackets = 200
+
+	ignore := persist.NewIgnoreList(persist.DEFAULT_IGNORE)
+	changes := persist.Detect(old.Id, old, new, ignore, 100)
+	byPath := make(map[string]*types.InventoryChange)
+	for _, change := range changes {
+		byPath[change.Path] = change
+	}
+	if len(changes) != 4 {
+		t.Fatalf("Expected 4 changes, got %v", changes)
+	}
+	serial := byPath["equipmentinfo.serial_number"]
+	if serial == nil || serial.Kind != types.InventoryChangeKind_INVENTORY_CHANGE_MODIFIED ||
+		serial.OldValue != "SN1" || serial.NewValue != "SN2" || serial.Device != old.Id || serial.Time != 100 {
+		t.Fatalf("Unexpected serial change %v", serial)
+	}
+	if byPath["equipmentinfo.software"] == nil {
+		t.Fatal("Expected a software change")
+	}
+	module := byPath["physicals[chassis].chassis[1].modules[m2]"]
+	if module == nil || module.Kind != types.InventoryChangeKind_INVENTORY_CHANGE_ADDED || module.NewValue != "m2" {
+		t.Fatalf("Expected an inserted module, got %v", changes)
+	}
+	description := byPath["physicals[chassis].ports[Gi0/1].interfaces[Gi0/1].description"]
+	if description == nil || description.NewValue != "uplink to core" {
+		t.Fatalf("Expected an edited interface description, got %v", changes)
+	}
+
+	all := persist.Detect(old.Id, old, new, persist.NewIgnoreList(nil), 100)
+	if len(all) != 7 {
+		t.Fatalf("Expected the counters without an ignore list, got %v", all)
+	}
+	added := persist.Detect(old.Id, nil, new, ignore, 100)
+	if len(added) != 1 || added[0].Kind != types.InventoryChangeKind_INVENTORY_CHANGE_ADDED || added[0].Path != "" {
+		t.Fatalf("Expected a single added device, got %v", added)
+	}
+	if !ignore.Ignored("physicals[chassis].ports[Gi0/1].interfaces[Gi0/1].statistics.rx_packets") ||
+		ignore.Ignored("equipmentinfo.serial_number") {
+		t.Fatal("Unexpected ignore list match")
+	}
+	if persist.Like("*.serial_number") != `%.serial\_number` {
+		t.Fatalf("Unexpected like pattern %s", persist.Like("*.serial_number"))
+	}
+	csv, err := persist.ChangesToCSV(&types.InventoryChangeList{List: changes})
+	if err != nil || len(csv) == 0 {
+		t.Fatal("Expected a csv export")
+	}
+}
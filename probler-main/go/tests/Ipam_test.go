@@ -0,0 +1,80 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/saichler/probler/go/services/ipam"
+	"github.com/saichler/probler/go/types"
+)
+
+func ipamDevice(id, managementIp string, interfaces ...*types.Interface) *types.NetworkDevice {
+	return &types.NetworkDevice{Id: id, Equipmentinfo: &types.EquipmentInfo{IpAddress: managementIp},
+		Logicals: map[string]*types.Logical{"0": {Id: "0", Interfaces: interfaces}}}
+}
+
+func TestIpam(t *testing.T) {
+	devices := []*types.NetworkDevice{
+		ipamDevice("r1", "10.0.0.1",
+			&types.Interface{Id: "ge1", Name: "ge1", IpAddress: "192.168.1.1/29", MacAddress: "00-1A-2B-3C-4D-5E"},
+			&types.Interface{Id: "lo0", Name: "lo0", IpAddress: "127.0.0.1"}),
+		ipamDevice("r2", "10.0.0.2",
+			&types.Interface{Id: "ge1", Name: "ge1", IpAddress: "192.168.1.2", MacAddress: "001a.2b3c.4d5e"},
+			&types.Interface{Id: "ge2", Name: "ge2", IpAddress: "10.0.0.1", MacAddress: "00:00:00:00:00:00"}),
+	}
+	annotations := []*types.IpamAnnotation{
+		{Address: "192.168.1.6", Reserved: true, Note: "gateway vip"},
+		{Address: "10.0.0.2", Note: "r2 management"},
+	}
+	report, err := ipam.Build(devices, annotations, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Prefixes) != 2 || report.Prefixes[0].Prefix != "10.0.0.0/24" || report.Prefixes[1].Prefix != "192.168.1.0/29" {
+		t.Fatalf("Expected the default and the polled prefixes, got %v", report.Prefixes)
+	}
+	subnet := report.Prefixes[1]
+	if subnet.Size != 6 || subnet.Used != 2 || subnet.Reserved != 1 || subnet.Utilization != 50 {
+		t.Fatalf("Unexpected utilization of %s, %d/%d of %d %f", subnet.Prefix, subnet.Used, subnet.Reserved,
+			subnet.Size, subnet.Utilization)
+	}
+	if len(subnet.Addresses) != 3 || subnet.Addresses[1].Address != "192.168.1.2" || subnet.Addresses[1].Prefix != subnet.Prefix {
+		t.Fatalf("Expected the address without a prefix to belong to the polled prefix, got %v", subnet.Addresses)
+	}
+	management := report.Prefixes[0]
+	if management.Used != 2 || !management.Addresses[0].Duplicate || management.Addresses[1].Duplicate ||
+		management.Addresses[1].Annotation.Note != "r2 management" {
+		t.Fatalf("Unexpected management addresses %v", management.Addresses)
+	}
+
+	if len(report.Duplicates) != 2 ||
+		report.Duplicates[0].Kind != types.IpamDuplicateKind_IPAM_DUPLICATE_IP || report.Duplicates[0].Value != "10.0.0.1" ||
+		report.Duplicates[1].Kind != types.IpamDuplicateKind_IPAM_DUPLICATE_MAC || report.Duplicates[1].Value != "00:1a:2b:3c:4d:5e" {
+		t.Fatalf("Expected the duplicate ip and mac, got %v", report.Duplicates)
+	}
+
+	report, err = ipam.Build(devices, annotations, &types.IpamQuery{Prefix: "192.168.1.0/30"})
+	if err != nil || len(report.Prefixes) != 1 || len(report.Prefixes[0].Addresses) != 2 || report.Prefixes[0].Used != 2 {
+		t.Fatalf("Expected only the addresses in the queried prefix, got %v %v", report, err)
+	}
+	if _, err = ipam.Build(devices, nil, &types.IpamQuery{Prefix: "192.168.1"}); err == nil {
+		t.Fatal("Expected an invalid prefix to be rejected")
+	}
+	if ipam.NormalizeMac("FFFF.FFFF.FFFF") != "" || ipam.NormalizeMac("001A2B3C4D5E") != "00:1a:2b:3c:4d:5e" {
+		t.Fatal("Unexpected mac normalization")
+	}
+}
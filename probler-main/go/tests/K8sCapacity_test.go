@@ -0,0 +1,88 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/saichler/probler/go/services/k8scapacity"
+	"github.com/saichler/probler/go/services/k8sobjects"
+	"github.com/saichler/probler/go/types"
+)
+
+const nodesJson = `{"apiVersion": "v1", "kind": "List", "items": [
+  {"kind": "Node", "metadata": {"name": "n1"},
+   "status": {"allocatable": {"cpu": "4", "memory": "8Gi", "pods": "110"},
+     "conditions": [{"type": "Ready", "status": "True"}]}},
+  {"kind": "Node", "metadata": {"name": "n2"}, "spec": {"unschedulable": true},
+   "status": {"allocatable": {"cpu": "4", "memory": "8Gi", "pods": "110"},
+     "conditions": [{"type": "Ready", "status": "True"}]}}
+]}`
+
+func TestK8sCapacity(t *testing.T) {
+	for quantity, expected := range map[string]float64{"500m": 0.5, "2": 2, "1Ki": 1024, "1G": 1e9, "129e6": 129e6} {
+		value, ok := k8scapacity.ParseQuantity(quantity)
+		if !ok || value != expected {
+			t.Fatalf("Expected %s to be %f, got %f", quantity, expected, value)
+		}
+	}
+
+	objects := &types.K8SObjects{}
+	_, err := k8sobjects.Map([]byte(nodesJson), objects, k8sobjects.Resources[6])
+	if err != nil {
+		t.Fatal(err)
+	}
+	container := func(cpu, memory, cpuLimit string) *types.Container {
+		return &types.Container{Resources: &types.ResourceRequirements{
+			Requests: map[string]string{"cpu": cpu, "memory": memory}, Limits: map[string]string{"cpu": cpuLimit}}}
+	}
+	objects.Pods = map[string]*types.Pod{
+		"a": {Spec: &types.PodSpec{NodeName: "n1", Containers: []*types.Container{container("1", "1Gi", "4"), container("500m", "1Gi", "2")}}},
+		"b": {Spec: &types.PodSpec{NodeName: "n1", Containers: []*types.Container{container("100m", "512Mi", "")},
+			InitContainers: []*types.Container{container("1", "0", "")}}},
+		"c": {Spec: &types.PodSpec{NodeName: "n1", Containers: []*types.Container{container("2", "4Gi", "")}},
+			Status: &types.PodStatus{Phase: "Succeeded"}},
+	}
+
+	report := k8scapacity.Compute(&types.K8SCluster{Name: "lab", Objects: objects}, 500, 1<<30)
+	n1 := report.Nodes[0]
+	if n1.RequestedCpu != 2500 || n1.RequestedMemory != 2560<<20 || n1.PodCount != 2 || n1.CpuOvercommit != 1.5 {
+		t.Fatalf("Unexpected node capacity %v", n1)
+	}
+	//Limited by the 1.5 free cores
+	if n1.PodsFit != 3 || report.Nodes[1].Schedulable || report.Nodes[1].PodsFit != 0 {
+		t.Fatalf("Unexpected fit %v", report.Nodes)
+	}
+	if report.Total.AllocatableCpu != 4000 || report.Total.PodsFit != 3 || report.Total.CpuRequestRatio != 0.63 {
+		t.Fatalf("Unexpected total %v", report.Total)
+	}
+
+	//Without a pod slot limit a pod of no size is not limited, the pod slots default to the capacity
+	ready := []*types.KCondition{{Type: "Ready", Status: "True"}}
+	objects = &types.K8SObjects{Nodes: map[string]*types.Node{
+		"a": {Metadata: &types.ObjectMeta{Name: "a"}, Status: &types.NodeStatus{Conditions: ready}},
+		"b": {Metadata: &types.ObjectMeta{Name: "b"}, Status: &types.NodeStatus{Conditions: ready}},
+		"c": {Metadata: &types.ObjectMeta{Name: "c"}, Status: &types.NodeStatus{Conditions: ready,
+			Capacity: map[string]string{"pods": "110"}}}}}
+	report = k8scapacity.Compute(&types.K8SCluster{Name: "lab", Objects: objects}, 0, 0)
+	if !report.Nodes[0].PodsFitUnbounded || report.Nodes[0].PodsFit != 0 || report.Nodes[2].AllocatablePods != 110 ||
+		report.Nodes[2].PodsFitUnbounded || report.Nodes[2].PodsFit != 110 {
+		t.Fatalf("Unexpected unbounded fit %v", report.Nodes)
+	}
+	if !report.Total.PodsFitUnbounded || report.Total.PodsFit != 110 {
+		t.Fatalf("Unexpected unbounded total %v", report.Total)
+	}
+}
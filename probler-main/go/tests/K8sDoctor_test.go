@@ -0,0 +1,76 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/saichler/probler/go/services/k8sdoctor"
+	"github.com/saichler/probler/go/types"
+)
+
+func TestK8sDoctor(t *testing.T) {
+	now := time.Now().Unix()
+	cluster := &types.K8SCluster{Name: "lab",
+		Nodes: map[string]*types.K8SNode{"n1": {Name: "n1", Status: types.K8SNodeStatus_Ready}, "n2": {Name: "n2"}},
+		Pods: map[string]*types.K8SPod{
+			"a": {Namespace: "shop", Name: "web-1", Status: types.K8SPodStatus_Running, Restarts: &types.K8SRestartsState{Count: 2}},
+			"b": {Namespace: "shop", Name: "web-2", Status: types.K8SPodStatus_Pending, Age: &types.K8SAge{Created: now - 600}},
+			"c": {Namespace: "shop", Name: "web-3", Status: types.K8SPodStatus_Pending, Age: &types.K8SAge{Created: now - 60}},
+			"d": {Namespace: "data", Name: "db-0", Status: types.K8SPodStatus_CrashLoopBackOff},
+		},
+		Deployments: map[string]*types.K8SDeployment{
+			"web": {Namespace: "shop", Name: "web", Ready: &types.K8SReadyState{Count: 1, Outof: 3}, Available: &types.K8SCount{Count: 1}},
+			"api": {Namespace: "shop", Name: "api", Ready: &types.K8SReadyState{Count: 2, Outof: 2}, Available: &types.K8SCount{Count: 2},
+				UpToDate: &types.K8SCount{Count: 1}},
+		},
+		Daemonsets: map[string]*types.K8SDaemonSet{
+			"proxy": {Namespace: "kube-system", Name: "proxy", Desired: &types.K8SCount{Count: 2}, Ready: &types.K8SCount{Count: 1}},
+		},
+	}
+
+	restarts := k8sdoctor.NewRestarts(time.Minute * 15)
+	restarts.Sample(cluster, now-120)
+	cluster.Pods["a"].Restarts.Count = 6
+	restarts.Sample(cluster, now)
+
+	report := k8sdoctor.Analyze(cluster, restarts, 0, now)
+	kinds := map[types.K8SProblemKind]int{}
+	for _, problem := range report.Problems {
+		kinds[problem.Kind]++
+		if len(problem.NextSteps) == 0 {
+			t.Fatalf("Expected next steps for %v", problem)
+		}
+	}
+	if report.CriticalCount != 2 || report.WarningCount != 4 || report.InfoCount != 1 {
+		t.Fatalf("Unexpected counts %d %d %d", report.CriticalCount, report.WarningCount, report.InfoCount)
+	}
+	if kinds[types.K8SProblemKind_PodPending] != 1 || kinds[types.K8SProblemKind_RestartsRising] != 1 ||
+		kinds[types.K8SProblemKind_NodeNotReady] != 1 || kinds[types.K8SProblemKind_DaemonSetMissing] != 1 {
+		t.Fatalf("Unexpected problems %v", kinds)
+	}
+	if report.Problems[0].Severity != types.K8SProblemSeverity_ProblemCritical {
+		t.Fatalf("Expected the critical problems first")
+	}
+
+	//A pod recreated with the same name starts its count over
+	cluster.Pods["a"].Restarts.Count = 0
+	restarts.Sample(cluster, now+60)
+	if restarts.Rise("lab", cluster.Pods["a"]) != 0 {
+		t.Fatal("Expected no rise after the count started over")
+	}
+}
@@ -0,0 +1,73 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/saichler/probler/go/services/k8sdrift"
+	"github.com/saichler/probler/go/types"
+)
+
+func driftPolicy(app string) map[string]*types.NetworkPolicy {
+	return map[string]*types.NetworkPolicy{"shop/deny": {Metadata: &types.ObjectMeta{Namespace: "shop", Name: "deny"},
+		Spec: &types.NetworkPolicySpec{PodSelector: &types.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Ingress: []*types.NetworkPolicyIngressRule{{From: []*types.NetworkPolicyPeer{
+				{PodSelector: &types.LabelSelector{MatchLabels: map[string]string{"app": app}}}}}}}}}
+}
+
+func TestK8sDrift(t *testing.T) {
+	staging := &types.K8SCluster{Name: "staging",
+		Deployments: map[string]*types.K8SDeployment{
+			"a": {Namespace: "shop", Name: "web", Images: "nginx:1.25,envoy:1.2", Ready: &types.K8SReadyState{Outof: 2}},
+			"b": {Namespace: "shop", Name: "api", Images: "api:2", Ready: &types.K8SReadyState{Outof: 1}},
+			"c": {Namespace: "other", Name: "web", Images: "nginx:1.0"},
+		},
+		Services: map[string]*types.K8SService{"a": {Namespace: "shop", Name: "web", Type: "NodePort", Ports: "80:31234/TCP,443:31235/TCP"},
+			"b": {Namespace: "shop", Name: "cache", Type: "ClusterIP", Ports: "6379/TCP"}},
+		Networkpolicies: map[string]*types.K8SNetworkPolicy{"a": {Namespace: "shop", Name: "deny", PodSelector: "app=web"}},
+		Objects:         &types.K8SObjects{Networkpolicies: driftPolicy("frontend")},
+	}
+	production := &types.K8SCluster{Name: "production",
+		Deployments: map[string]*types.K8SDeployment{
+			"a": {Namespace: "shop", Name: "web", Images: "envoy:1.2, nginx:1.25", Ready: &types.K8SReadyState{Outof: 4}},
+			"b": {Namespace: "shop", Name: "api", Images: "api:1", Ready: &types.K8SReadyState{Outof: 1}},
+		},
+		Services:        map[string]*types.K8SService{"a": {Namespace: "shop", Name: "web", Type: "NodePort", Ports: "443:30443/TCP, 80:30080/TCP"}},
+		Networkpolicies: map[string]*types.K8SNetworkPolicy{"a": {Namespace: "shop", Name: "deny", PodSelector: "app=web"}},
+		Objects:         &types.K8SObjects{Networkpolicies: driftPolicy("gateway")},
+	}
+
+	drift := k8sdrift.Diff(staging, production, "shop", "")
+	if drift.Left != "staging/shop" || drift.Right != "production/shop" || drift.MatchCount != 1 || len(drift.Items) != 4 {
+		t.Fatalf("Unexpected drift %v", drift)
+	}
+	kinds := map[types.K8SDriftKind]*types.K8SDriftItem{}
+	for _, item := range drift.Items {
+		kinds[item.Kind] = item
+	}
+	if kinds[types.K8SDriftKind_ReplicaDrift].Name != "web" || kinds[types.K8SDriftKind_ReplicaDrift].Right != "4" ||
+		kinds[types.K8SDriftKind_ImageDrift].Name != "api" || kinds[types.K8SDriftKind_OnlyInLeft].Name != "cache" ||
+		!strings.Contains(kinds[types.K8SDriftKind_NetworkPolicyDrift].Right, "gateway") {
+		t.Fatalf("Unexpected drift items %v", drift.Items)
+	}
+
+	drift = k8sdrift.Diff(staging, production, "", "")
+	if drift.Left != "staging" || drift.Items[0].Name != "other/web" || drift.Items[0].Kind != types.K8SDriftKind_OnlyInLeft {
+		t.Fatalf("Unexpected cluster drift %v", drift.Items)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/saichler/probler/go/services/k8sevents"
+	"github.com/saichler/probler/go/services/k8sobjects"
+	"github.com/saichler/probler/go/types"
+)
+
+const eventsJson = `{"apiVersion": "v1", "kind": "List", "items": [
+  {"kind": "Event", "metadata": {"name": "web-1.17a", "namespace": "shop"},
+   "involvedObject": {"kind": "Pod", "name": "web-1", "namespace": "shop"},
+   "reason": "BackOff", "message": "Back-off restarting failed container", "type": "Warning", "count": 12,
+   "firstTimestamp": "2025-01-01T10:00:00Z", "lastTimestamp": "2025-01-01T10:30:00Z"},
+  {"kind": "Event", "metadata": {"name": "node-2.17b"},
+   "involvedObject": {"kind": "Node", "name": "node-2"},
+   "reason": "EvictionThresholdMet", "type": "Warning", "eventTime": "2025-01-01T10:10:00.123456Z"}
+]}`
+
+func TestK8sEvents(t *testing.T) {
+	if k8sevents.WarningEvents.Command() != "get events -A --field-selector type=Warning -o json" {
+		t.Fatalf("Unexpected command %s", k8sevents.WarningEvents.Command())
+	}
+	objects := &types.K8SObjects{}
+	count, err := k8sobjects.Map([]byte(eventsJson), objects, k8sevents.WarningEvents)
+	if err != nil || count != 2 {
+		t.Fatalf("Expected 2 events, got %d %v", count, err)
+	}
+	controller := func(kind, name string) *types.ObjectMeta {
+		return &types.ObjectMeta{OwnerReferences: []*types.OwnerReference{{Kind: kind, Name: name, Controller: true}}}
+	}
+	cluster := &types.K8SCluster{Name: "lab", Objects: &types.K8SObjects{
+		Pods:        map[string]*types.Pod{"shop/web-1": {Metadata: controller("ReplicaSet", "web-5d8"), Spec: &types.PodSpec{NodeName: "node-1"}}},
+		Replicasets: map[string]*types.ReplicaSet{"shop/web-5d8": {Metadata: controller("Deployment", "web")}},
+	}}
+	events := k8sevents.Events(cluster, objects.Events)
+	backoff := events["shop/web-1.17a"]
+	if backoff == nil || backoff.Pod != "web-1" || backoff.Node != "node-1" || backoff.WorkloadKind != "Deployment" ||
+		backoff.WorkloadName != "web" || backoff.Count != 12 || backoff.LastSeen-backoff.FirstSeen != 1800 {
+		t.Fatalf("Unexpected event %v", backoff)
+	}
+	eviction := events["node-2.17b"]
+	if eviction == nil || eviction.Node != "node-2" || eviction.Count != 1 || eviction.FirstSeen != eviction.LastSeen {
+		t.Fatalf("Unexpected event %v", eviction)
+	}
+
+	store := k8sevents.NewStore(3)
+	store.Add("lab", events)
+	list := store.Query(&types.K8SEventQuery{Object: "web"})
+	if len(list.List) != 1 || list.List[0].Reason != "BackOff" {
+		t.Fatalf("Expected the deployment event, got %v", list.List)
+	}
+	more := make(map[string]*types.K8SEvent)
+	for i := 0; i < 3; i++ {
+		key := fmt.Sprintf("shop/db-%d", i)
+		more[key] = &types.K8SEvent{Cluster: "lab", Key: key, LastSeen: backoff.LastSeen + int64(i+1)}
+	}
+	store.Add("lab", more)
+	list = store.Query(&types.K8SEventQuery{Cluster: "lab"})
+	if len(list.List) != 3 || list.List[0].Key != "shop/db-2" {
+		t.Fatalf("Expected the 3 most recent events, got %v", list.List)
+	}
+	if len(store.Query(&types.K8SEventQuery{Cluster: "other"}).List) != 0 {
+		t.Fatal("Expected no events of another cluster")
+	}
+}
@@ -0,0 +1,93 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/saichler/probler/go/services/k8sobjects"
+	"github.com/saichler/probler/go/types"
+)
+
+const podsJson = `kubectl output
+{"apiVersion": "v1", "kind": "List", "items": [
+  {"apiVersion": "v1", "kind": "Pod",
+   "metadata": {"name": "web-1", "namespace": "shop", "labels": {"app": "web"}, "generation": 2},
+   "spec": {"containers": [
+       {"name": "web", "image": "nginx:1.25", "resources": {"limits": {"cpu": "500m", "memory": "256Mi"}},
+        "ports": [{"containerPort": 80, "protocol": "TCP"}],
+        "livenessProbe": {"httpGet": {"path": "/", "port": 80}}},
+       {"name": "sidecar", "image": "envoy", "resources": {"requests": {"memory": "64Mi"}}}],
+     "initContainers": [{"name": "init", "image": "busybox"}]},
+   "status": {"phase": "Running", "podIPs": [{"ip": "10.1.1.1"}],
+     "containerStatuses": [{"name": "web", "ready": true, "restartCount": 3}]}},
+  {"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "db-0", "namespace": "data"},
+   "spec": {"containers": [{"name": "db", "image": "postgres", "resources": {"limits": {"memory": "1Gi"}}}]}}
+]}`
+
+func TestK8sObjectsMapper(t *testing.T) {
+	objects := &types.K8SObjects{}
+	count, err := k8sobjects.Map([]byte(podsJson), objects, k8sobjects.Resources[0])
+	if err != nil || count != 2 {
+		t.Fatalf("Expected 2 pods, got %d %v", count, err)
+	}
+	pod := objects.Pods["shop/web-1"]
+	if pod == nil || pod.Metadata.Labels["app"] != "web" || pod.Metadata.Generation != 2 || pod.Status.Phase != "Running" {
+		t.Fatalf("Unexpected pod %v", pod)
+	}
+	web := pod.Spec.Containers[0]
+	if web.Ports[0].ContainerPort != 80 || web.LivenessProbe.HttpGet.Port != "80" || web.Resources.Limits["memory"] != "256Mi" {
+		t.Fatalf("Unexpected container %v", web)
+	}
+	if pod.Status.ContainerStatuses[0].RestartCount != 3 || len(pod.Status.PodIps) != 0 {
+		t.Fatalf("Unexpected pod status %v", pod.Status)
+	}
+
+	clusters := []*types.K8SCluster{{Name: "lab", Objects: objects}}
+	list := k8sobjects.Containers(clusters, &types.K8SContainerQuery{MissingLimit: "memory"})
+	if len(list.List) != 2 || list.List[0].Name != "init" || !list.List[0].Init || list.List[1].Name != "sidecar" {
+		t.Fatalf("Expected the init and sidecar containers to have no memory limit, got %v", list.List)
+	}
+	list = k8sobjects.Containers(clusters, &types.K8SContainerQuery{Namespace: "data"})
+	if len(list.List) != 1 || list.List[0].Pod != "db-0" {
+		t.Fatalf("Expected only the db container, got %v", list.List)
+	}
+}
+
+func TestK8sObjectsConfigMapMetadataOnly(t *testing.T) {
+	data := `{"items": [{"apiVersion": "v1", "kind": "ConfigMap",
+	  "metadata": {"name": "app", "namespace": "shop", "annotations": {"owner": "team-a",
+	    "kubectl.kubernetes.io/last-applied-configuration": "{\"data\":{\"password\":\"secret\"}}"}},
+	  "data": {"password": "secret"}}]}`
+	var configMaps *k8sobjects.Resource
+	for _, resource := range k8sobjects.Resources {
+		if resource.Name == "configmaps" {
+			configMaps = resource
+		}
+	}
+	objects := &types.K8SObjects{}
+	_, err := k8sobjects.Map([]byte(data), objects, configMaps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cm := objects.Configmaps["shop/app"]
+	if cm == nil || len(cm.Data) != 0 || cm.Kind != "ConfigMap" || cm.Metadata.Annotations["owner"] != "team-a" {
+		t.Fatalf("Unexpected config map %v", cm)
+	}
+	if _, ok := cm.Metadata.Annotations[k8sobjects.LAST_APPLIED_ANNOTATION]; ok {
+		t.Fatal("Expected the last applied configuration to be dropped")
+	}
+}
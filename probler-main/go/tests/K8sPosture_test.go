@@ -0,0 +1,84 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/saichler/probler/go/services/k8sobjects"
+	"github.com/saichler/probler/go/services/k8sposture"
+	"github.com/saichler/probler/go/types"
+)
+
+const postureJson = `{"apiVersion": "v1", "kind": "List", "items": [
+  {"kind": "Deployment", "metadata": {"name": "agent", "namespace": "ops"},
+   "spec": {"template": {"spec": {"hostNetwork": true, "securityContext": {"runAsNonRoot": true},
+     "volumes": [{"name": "docker", "hostPath": {"path": "/var/run/docker.sock"}}],
+     "containers": [{"name": "agent", "image": "agent",
+       "securityContext": {"privileged": true, "capabilities": {"add": ["SYS_ADMIN"]}},
+       "env": [{"name": "TOKEN", "valueFrom": {"secretKeyRef": {"name": "agent-token", "key": "token"}}}],
+       "envFrom": [{"secretRef": {"name": "agent-env"}}]}]}}}},
+  {"kind": "Deployment", "metadata": {"name": "web", "namespace": "shop"},
+   "spec": {"template": {"spec": {"containers": [{"name": "web", "image": "nginx",
+     "securityContext": {"runAsUser": 1000}}]}}}}
+]}`
+
+func TestK8sPosture(t *testing.T) {
+	objects := &types.K8SObjects{}
+	_, err := k8sobjects.Map([]byte(postureJson), objects, k8sobjects.Resources[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	objects.Clusterroles = map[string]*types.ClusterRole{
+		"admin-all": {Metadata: &types.ObjectMeta{Name: "admin-all"},
+			Rules: []*types.PolicyRule{{Verbs: []string{"*"}, Resources: []string{"*"}, ApiGroups: []string{"*"}}}},
+		"cluster-admin": {Metadata: &types.ObjectMeta{Name: "cluster-admin", Labels: map[string]string{k8sposture.BOOTSTRAPPING_LABEL: "rbac-defaults"}},
+			Rules: []*types.PolicyRule{{Verbs: []string{"*"}, Resources: []string{"*"}}}},
+	}
+	objects.Namespaces = map[string]*types.Namespace{
+		"ops": {Metadata: &types.ObjectMeta{Name: "ops"}}, "shop": {Metadata: &types.ObjectMeta{Name: "shop"}},
+		"kube-system": {Metadata: &types.ObjectMeta{Name: "kube-system"}},
+	}
+	objects.Networkpolicies = map[string]*types.NetworkPolicy{"shop/deny": {Metadata: &types.ObjectMeta{Name: "deny", Namespace: "shop"}}}
+
+	report := k8sposture.Scan(&types.K8SCluster{Name: "lab", Objects: objects}, 100)
+	rules := map[types.K8SPostureRule]int{}
+	for _, finding := range report.Findings {
+		rules[finding.Rule]++
+	}
+	if rules[types.K8SPostureRule_PrivilegedContainer] != 2 || rules[types.K8SPostureRule_HostNamespace] != 1 ||
+		rules[types.K8SPostureRule_HostPathVolume] != 1 || rules[types.K8SPostureRule_SecretInEnv] != 2 ||
+		rules[types.K8SPostureRule_WildcardRole] != 1 || rules[types.K8SPostureRule_NamespaceWithoutNetworkPolicy] != 1 ||
+		rules[types.K8SPostureRule_RunAsRoot] != 0 {
+		t.Fatalf("Unexpected findings %v", rules)
+	}
+	if report.CriticalCount != 4 || report.NamespaceCounts["ops"] != 7 || report.Findings[0].Severity != types.K8SProblemSeverity_ProblemCritical {
+		t.Fatalf("Unexpected report %v", report)
+	}
+	if len(k8sposture.Filter(report, "shop").Findings) != 0 {
+		t.Fatal("Expected no findings in shop")
+	}
+
+	trend := k8sposture.NewTrend(2)
+	for i := int64(1); i <= 3; i++ {
+		report.Scanned = i
+		trend.Add(report)
+	}
+	points := trend.Points("lab")
+	if len(points) != 2 || points[0].Time != 2 || points[1].RuleCounts["SecretInEnv"] != 2 {
+		t.Fatalf("Unexpected trend %v", points)
+	}
+}
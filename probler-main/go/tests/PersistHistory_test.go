@@ -0,0 +1,134 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/saichler/l8pollaris/go/pollaris/targets"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/conditions"
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPersistHistory(t *testing.T) {
+	v1 := &types.NetworkDevice{Id: "10.1.1.1",
+		Equipmentinfo: &types.EquipmentInfo{SerialNumber: "SN1", Version: "17.1"},
+		Physicals: map[string]*types.Physical{
+			"chassis": {Id: "chassis", Ports: []*types.Port{{Id: "Gi0/1"}}},
+			"psu":     {Id: "psu"}}}
+	v2 := proto.Clone(v1).(*types.NetworkDevice)
+	v2.Equipmentinfo.Version = "17.2"
+	v2.Equipmentinfo.SerialNumber = ""
+	v2.Physicals["chassis"].Ports = append(v2.Physicals["chassis"].Ports, &types.Port{Id: "Gi0/2"})
+	delete(v2.Physicals, "psu")
+	v3 := proto.Clone(v2).(*types.NetworkDevice)
+	v3.Equipmentinfo.Version = "17.3"
+
+	first, err := persist.Delta(v1.Id, nil, v1, 100)
+	if err != nil || !first.Snapshot {
+		t.Fatal("Expected a snapshot of a new element")
+	}
+	second, err := persist.Delta(v1.Id, v1, v2, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := map[string]bool{}
+	for _, field := range second.Fields {
+		fields[field] = true
+	}
+	if len(fields) != 4 || !fields["equipmentinfo.version"] || !fields["equipmentinfo.serial_number"] ||
+		!fields["physicals[chassis]"] || !fields["physicals[psu]"] {
+		t.Fatalf("Unexpected changed fields %v", second.Fields)
+	}
+	if none, _ := persist.Delta(v1.Id, v2, proto.Clone(v2), 250); none != nil {
+		t.Fatal("Expected no delta without changes")
+	}
+	third, _ := persist.Delta(v1.Id, v2, v3, 300)
+
+	elem, err := persist.Replay(&types.NetworkDevice{}, []*types.InventoryDelta{first, second})
+	if err != nil || !proto.Equal(elem, v2) {
+		t.Fatalf("Expected the second version, got %v", elem)
+	}
+	elem, _ = persist.Replay(&types.NetworkDevice{}, []*types.InventoryDelta{first, second, third})
+	if !proto.Equal(elem, v3) {
+		t.Fatalf("Expected the third version, got %v", elem)
+	}
+	deleted := &types.InventoryDelta{Key: v1.Id, Time: 400, Snapshot: true, Deleted: true}
+	elem, _ = persist.Replay(&types.NetworkDevice{}, []*types.InventoryDelta{first, second, deleted})
+	if elem != nil {
+		t.Fatal("Expected no element after its deletion")
+	}
+
+	text, asOf, err := common.SplitAsOf("select * from NetworkDevice where Id=10.1.1.1 as of 2025-10-18T14:00:00Z")
+	if err != nil || text != "select * from NetworkDevice where Id=10.1.1.1" ||
+		asOf != time.Date(2025, 10, 18, 14, 0, 0, 0, time.UTC).Unix() {
+		t.Fatalf("Unexpected as of split %s %d %v", text, asOf, err)
+	}
+	if _, asOf, _ = common.SplitAsOf("select * from NetworkDevice"); asOf != 0 {
+		t.Fatal("Expected no as of time")
+	}
+	if _, _, err = common.SplitAsOf("select * from NetworkDevice as of yesterday"); err == nil {
+		t.Fatal("Expected an invalid time error")
+	}
+	if persist.QueryKey(text, "Id") != "10.1.1.1" || persist.QueryKey("select * from NetworkDevice", "Id") != "" {
+		t.Fatal("Unexpected query key")
+	}
+}
+
+func TestPersistWhere(t *testing.T) {
+	descriptor := (&types.NetworkDevice{}).ProtoReflect().Descriptor()
+	cisco := &types.NetworkDevice{Id: "10.1.1.1", Tags: map[string]string{"env": "prod"},
+		Equipmentinfo: &types.EquipmentInfo{Vendor: "Cisco", DeviceStatus: types.DeviceStatus_DEVICE_STATUS_ONLINE}}
+	juniper := &types.NetworkDevice{Id: "10.1.2.1", Equipmentinfo: &types.EquipmentInfo{Vendor: "Juniper"}}
+
+	where, err := conditions.Where(descriptor, "select * from NetworkDevice where equipmentinfo.vendor=cisco "+
+		"and equipmentinfo.devicestatus=DEVICE_STATUS_ONLINE and tag.env=prod and Id=10.1.*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(where) != 4 || !conditions.MatchAll(cisco, where) || conditions.MatchAll(juniper, where) {
+		t.Fatal("Expected the where clause to match only the cisco device")
+	}
+	where, _ = conditions.Where(descriptor, "select * from NetworkDevice where networkdevice.Id!=10.1.1.1")
+	if conditions.MatchAll(cisco, where) || !conditions.MatchAll(juniper, where) {
+		t.Fatal("Expected the not equals to match only the juniper device")
+	}
+	if where, err = conditions.Where(descriptor, "select * from NetworkDevice"); err != nil || len(where) != 0 {
+		t.Fatal("Expected no conditions without a where clause")
+	}
+	for _, text := range []string{
+		"select * from NetworkDevice where Id=10.1.1.1 or Id=10.1.2.1",
+		"select * from NetworkDevice where equipmentinfo.nosuchfield=x",
+		"select * from NetworkDevice where Id=10.1.1.1 sort-by Id",
+		"select * from NetworkDevice where Id>=10",
+		"select * from K8SCluster where Name=lab"} {
+		if _, err = conditions.Where(descriptor, text); err == nil {
+			t.Fatalf("Expected '%s' not to be applied", text)
+		}
+	}
+
+	targets.Links = &common.Links{}
+	if name, _ := common.QueryService(common.NetworkDevice_Links_ID, "select * from NetworkDevice"); name != common.NetDev_Cache_Service_Name {
+		t.Fatalf("Expected the cache to serve the query, got %s", name)
+	}
+	if name, _ := common.QueryService(common.NetworkDevice_Links_ID, "select * from NetworkDevice"+common.AsOf(time.Now())); name != common.NetDev_Persist_Service_Name {
+		t.Fatalf("Expected the persist service to serve the as of query, got %s", name)
+	}
+}
@@ -0,0 +1,159 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/saichler/l8pollaris/go/pollaris/targets"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// recordingDriver is a sql driver recording the statements executed, its queries return no rows.
+type recordingDriver struct {
+	mtx   sync.Mutex
+	execs []recordedExec
+}
+
+type recordedExec struct {
+	query string
+	args  []driver.Value
+}
+
+type recordingConn struct{ driver *recordingDriver }
+type recordingStmt struct {
+	driver *recordingDriver
+	query  string
+}
+type noRows struct{}
+
+func (this *recordingDriver) Open(name string) (driver.Conn, error) { return &recordingConn{this}, nil }
+func (this *recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return &recordingStmt{this.driver, query}, nil
+}
+func (this *recordingConn) Close() error              { return nil }
+func (this *recordingConn) Begin() (driver.Tx, error) { return nil, driver.ErrSkip }
+func (this *recordingStmt) Close() error              { return nil }
+func (this *recordingStmt) NumInput() int             { return -1 }
+func (this *recordingStmt) Exec(args []driver.Value) (driver.Result, error) {
+	this.driver.mtx.Lock()
+	defer this.driver.mtx.Unlock()
+	this.driver.execs = append(this.driver.execs, recordedExec{this.query, args})
+	return driver.RowsAffected(1), nil
+}
+func (this *recordingStmt) Query(args []driver.Value) (driver.Rows, error) { return &noRows{}, nil }
+func (this *noRows) Columns() []string                                     { return []string{"data"} }
+func (this *noRows) Close() error                                          { return nil }
+func (this *noRows) Next(dest []driver.Value) error                        { return io.EOF }
+
+// saved returns the elements saved to the table, in the order they were saved.
+func (this *recordingDriver) saved(table string) []*types.NetworkDevice {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	result := make([]*types.NetworkDevice, 0)
+	for _, exec := range this.execs {
+		if strings.HasPrefix(exec.query, "INSERT INTO "+table+" (") {
+			device := &types.NetworkDevice{}
+			if proto.Unmarshal(exec.args[1].([]byte), device) == nil {
+				result = append(result, device)
+			}
+		}
+	}
+	return result
+}
+
+func (this *recordingDriver) deleted(table string) int {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	count := 0
+	for _, exec := range this.execs {
+		if strings.HasPrefix(exec.query, "DELETE FROM "+table+" ") {
+			count++
+		}
+	}
+	return count
+}
+
+func TestPersistLink(t *testing.T) {
+	targets.Links = &common.Links{}
+	recorder := &recordingDriver{}
+	sql.Register("recording", recorder)
+	db, err := sql.Open("recording", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	service, err := persist.NewPersistService(common.NetworkDevice_Links_ID, &types.NetworkDevice{},
+		&types.NetworkDeviceList{}, "Id", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forwarder := persist.NewForwarder("Id", func(action ifs.Action, elem proto.Message) error {
+		var resp ifs.IElements
+		switch action {
+		case ifs.PUT:
+			resp = service.Put(object.New(nil, elem), nil)
+		case ifs.DELETE:
+			resp = service.Delete(object.New(nil, elem), nil)
+		}
+		return resp.Error()
+	})
+
+	device := &types.NetworkDevice{Id: "10.0.0.1", Equipmentinfo: &types.EquipmentInfo{SysName: "r1"}}
+	stale := &types.NetworkDevice{Id: "10.0.0.2"}
+	forwarder.Seed([]proto.Message{stale})
+	count, err := forwarder.Sync([]proto.Message{device})
+	if err != nil || count != 1 || recorder.deleted("networkdevice") != 0 {
+		t.Fatalf("Expected only the new device to be forwarded, not yet reloaded ones deleted, got %d %v", count, err)
+	}
+	saved := recorder.saved("networkdevice")
+	if len(saved) != 1 || saved[0].Id != "10.0.0.1" || saved[0].Equipmentinfo.SysName != "r1" {
+		t.Fatalf("Expected the cached device to be saved, got %v", saved)
+	}
+
+	count, _ = forwarder.Sync([]proto.Message{device, stale})
+	if count != 0 || len(recorder.saved("networkdevice")) != 1 {
+		t.Fatal("Expected an unchanged cache not to be forwarded")
+	}
+
+	updated := proto.Clone(device).(*types.NetworkDevice)
+	updated.Equipmentinfo.SysName = "r1.lab"
+	count, err = forwarder.Sync([]proto.Message{updated})
+	if err != nil || count != 2 {
+		t.Fatalf("Expected the update and the removal to be forwarded, got %d %v", count, err)
+	}
+	saved = recorder.saved("networkdevice")
+	if len(saved) != 2 || saved[1].Equipmentinfo.SysName != "r1.lab" {
+		t.Fatalf("Expected the cache update to be saved, got %v", saved)
+	}
+	if recorder.deleted("networkdevice") != 1 {
+		t.Fatal("Expected the device removed from the cache to be deleted")
+	}
+
+	count, _ = forwarder.Sync(nil)
+	if count != 0 || recorder.deleted("networkdevice") != 1 {
+		t.Fatal("Expected an empty cache not to delete the stored devices")
+	}
+}
@@ -0,0 +1,118 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/types"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPersistElements(t *testing.T) {
+	if persist.TableName(&types.K8SCluster{}) != "k8scluster" || persist.TableName(&types.NetworkDevice{}) != "networkdevice" {
+		t.Fatal("Unexpected table names")
+	}
+	if persist.Key(&types.K8SCluster{Name: "lab"}, "Name") != "lab" || persist.Key(&types.NetworkDevice{Id: "10.0.0.1"}, "Id") != "10.0.0.1" {
+		t.Fatal("Unexpected keys")
+	}
+	if persist.Key(&types.K8SCluster{Name: "lab"}, "Id") != "" {
+		t.Fatal("Expected no key for an unknown field")
+	}
+	elems := []proto.Message{&types.K8SCluster{Name: "a"}, &types.K8SCluster{Name: "b"}}
+	list, err := persist.NewList(&types.K8SClusterList{}, elems)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clusters, ok := list.(*types.K8SClusterList)
+	if !ok || len(clusters.List) != 2 || clusters.List[1].Name != "b" {
+		t.Fatalf("Unexpected list %v", list)
+	}
+	if len(persist.Elements(list)) != 2 {
+		t.Fatal("Expected the elements of the list")
+	}
+	if _, err = persist.NewList(&types.K8SCluster{}, elems); err == nil {
+		t.Fatal("Expected an error for a message without a list")
+	}
+}
+
+func TestPersistMigrations(t *testing.T) {
+	if persist.Backoff(0) != persist.DB_BACKOFF_MIN || persist.Backoff(1) != 2*persist.DB_BACKOFF_MIN ||
+		persist.Backoff(100) != persist.DB_BACKOFF_MAX {
+		t.Fatal("Unexpected backoff")
+	}
+	if err := persist.Validate(persist.Migrations); err != nil {
+		t.Fatal(err)
+	}
+	outOfOrder := []*persist.Migration{{Version: 1}, {Version: 3}, {Version: 2}}
+	if persist.Validate(outOfOrder) == nil {
+		t.Fatal("Expected an out of order error")
+	}
+	pending := persist.Pending(persist.Migrations, 1)
+	if len(pending) != len(persist.Migrations)-1 || pending[0].Version != 2 {
+		t.Fatalf("Unexpected pending migrations %v", pending)
+	}
+	if len(persist.Pending(persist.Migrations, persist.Migrations[len(persist.Migrations)-1].Version)) != 0 {
+		t.Fatal("Expected no pending migrations at the latest version")
+	}
+}
+
+func TestPersistSchema(t *testing.T) {
+	columns := persist.Columns(&types.NetworkDevice{})
+	byName := make(map[string]*persist.Column)
+	for _, column := range columns {
+		byName[column.Name] = column
+	}
+	if byName["id"] != nil || byName["equipmentinfo_serial_number"] == nil ||
+		byName["equipmentinfo_device_status"].Type != "integer" || byName["equipmentinfo_is_fru"].Type != "boolean" {
+		t.Fatal("Unexpected network device columns")
+	}
+	device := &types.NetworkDevice{}
+	if byName["equipmentinfo_serial_number"].Value(device) != nil {
+		t.Fatal("Expected no value without equipment info")
+	}
+	device.Equipmentinfo = &types.EquipmentInfo{SerialNumber: "SN1"}
+	if byName["equipmentinfo_serial_number"].Value(device) != "SN1" {
+		t.Fatal("Unexpected serial number value")
+	}
+
+	model := []*persist.Column{{Name: "name", Type: "text"}, {Name: "objects_collected", Type: "bigint"}}
+	live := map[string]string{"id": "text", "data": "bytea", "updated": "bigint",
+		"objects_collected": "integer", "legacy": "text"}
+	changes := persist.Diff("k8scluster", model, live)
+	if len(changes) != 3 {
+		t.Fatalf("Expected 3 changes, got %d", len(changes))
+	}
+	if changes[0].Column != "legacy" || changes[0].Kind != types.SchemaChangeKind_SCHEMA_CHANGE_DROP_COLUMN ||
+		!changes[0].Destructive {
+		t.Fatalf("Unexpected drop %v", changes[0])
+	}
+	if changes[1].Kind != types.SchemaChangeKind_SCHEMA_CHANGE_ADD_COLUMN || changes[1].Destructive ||
+		changes[1].Statement != "ALTER TABLE k8scluster ADD COLUMN name text" {
+		t.Fatalf("Unexpected add %v", changes[1])
+	}
+	if changes[2].Kind != types.SchemaChangeKind_SCHEMA_CHANGE_ALTER_TYPE || !changes[2].Destructive ||
+		changes[2].FromType != "integer" || changes[2].ToType != "bigint" {
+		t.Fatalf("Unexpected alter %v", changes[2])
+	}
+	if len(persist.Diff("k8scluster", model, map[string]string{"name": "text", "objects_collected": "bigint"})) != 0 {
+		t.Fatal("Expected no changes for a migrated table")
+	}
+	if persist.Validate([]*persist.Migration{{Version: persist.MODEL_MIGRATION_BASE}}) == nil {
+		t.Fatal("Expected a static migration in the model range to fail")
+	}
+}
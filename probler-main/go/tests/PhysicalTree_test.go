@@ -0,0 +1,100 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/saichler/probler/go/services/physicaltree"
+	"github.com/saichler/probler/go/types"
+)
+
+func addPhysical(device *types.NetworkDevice, key string, index, containedIn uint32, pos int32, class types.PhysicalClass) {
+	device.Physicals[key] = &types.Physical{Id: key, PhysicalIndex: index, ContainedIn: containedIn,
+		ParentRelPos: pos, PhysicalClass: class}
+}
+
+func TestPhysicalTree(t *testing.T) {
+	device := &types.NetworkDevice{Id: "10.20.30.1", Physicals: make(map[string]*types.Physical)}
+	addPhysical(device, "chassis", 1, 0, -1, types.PhysicalClass_PHYSICAL_CLASS_CHASSIS)
+	addPhysical(device, "slot-2", 3, 1, 2, types.PhysicalClass_PHYSICAL_CLASS_CONTAINER)
+	addPhysical(device, "slot-1", 2, 1, 1, types.PhysicalClass_PHYSICAL_CLASS_CONTAINER)
+	addPhysical(device, "module-1", 4, 2, 0, types.PhysicalClass_PHYSICAL_CLASS_MODULE)
+	addPhysical(device, "port-1", 5, 4, 0, types.PhysicalClass_PHYSICAL_CLASS_PORT)
+	addPhysical(device, "sensor-1", 9, 4, 1, types.PhysicalClass_PHYSICAL_CLASS_SENSOR)
+	addPhysical(device, "orphan", 10, 50, 0, types.PhysicalClass_PHYSICAL_CLASS_FAN)
+	addPhysical(device, "cycle-a", 11, 12, 0, types.PhysicalClass_PHYSICAL_CLASS_OTHER)
+	addPhysical(device, "cycle-b", 12, 11, 0, types.PhysicalClass_PHYSICAL_CLASS_OTHER)
+
+	tree := physicaltree.Build(device)
+
+	if tree.EntityCount != 9 {
+		t.Fatalf("Expected 9 entities, got %d", tree.EntityCount)
+	}
+	if len(tree.Roots) != 1 || tree.Roots[0].Id != "chassis" {
+		t.Fatalf("Expected the chassis as the only root, got %v", tree.Roots)
+	}
+	chassis := tree.Roots[0]
+	if len(chassis.Children) != 2 || chassis.Children[0].Id != "slot-1" || chassis.Children[1].Id != "slot-2" {
+		t.Fatalf("Expected slots ordered by relative position, got %v", chassis.Children)
+	}
+	module := chassis.Children[0].Children[0]
+	if module.Id != "module-1" || module.Depth != 2 || len(module.Children) != 2 {
+		t.Fatalf("Unexpected module node %v", module)
+	}
+	if module.Children[1].Id != "sensor-1" || module.Children[1].Depth != 3 {
+		t.Fatalf("Unexpected sensor node %v", module.Children[1])
+	}
+	if len(tree.Orphans) != 2 || tree.Orphans[0].Id != "orphan" || tree.Orphans[1].Id != "cycle-a" {
+		t.Fatalf("Expected the missing parent and the cycle as orphans, got %v", tree.Orphans)
+	}
+	if len(tree.Orphans[1].Children) != 1 || tree.Orphans[1].Children[0].Id != "cycle-b" {
+		t.Fatalf("Expected the cycle to be broken at the lowest index, got %v", tree.Orphans[1])
+	}
+	if len(tree.IndexGaps) != 1 || tree.IndexGaps[0].First != 6 || tree.IndexGaps[0].Last != 8 {
+		t.Fatalf("Expected a single gap of 6-8, got %v", tree.IndexGaps)
+	}
+}
+
+func TestPhysicalTreeDuplicateIndex(t *testing.T) {
+	device := &types.NetworkDevice{Id: "10.20.30.2", Physicals: make(map[string]*types.Physical)}
+	addPhysical(device, "a", 1, 0, 0, types.PhysicalClass_PHYSICAL_CLASS_CHASSIS)
+	addPhysical(device, "b", 1, 0, 0, types.PhysicalClass_PHYSICAL_CLASS_CHASSIS)
+
+	tree := physicaltree.Build(device)
+	if len(tree.DuplicateIndexes) != 1 || tree.DuplicateIndexes[0] != 1 {
+		t.Fatalf("Expected index 1 to be reported as duplicate, got %v", tree.DuplicateIndexes)
+	}
+	if len(tree.Roots) != 1 || len(tree.Orphans) != 1 {
+		t.Fatalf("Expected one root and one orphan, got %d/%d", len(tree.Roots), len(tree.Orphans))
+	}
+}
+
+func TestPhysicalTreeBelowCycle(t *testing.T) {
+	device := &types.NetworkDevice{Id: "10.20.30.3", Physicals: make(map[string]*types.Physical)}
+	addPhysical(device, "port", 2, 21, 0, types.PhysicalClass_PHYSICAL_CLASS_PORT)
+	addPhysical(device, "cycle-a", 20, 21, 0, types.PhysicalClass_PHYSICAL_CLASS_OTHER)
+	addPhysical(device, "cycle-b", 21, 20, 0, types.PhysicalClass_PHYSICAL_CLASS_OTHER)
+
+	tree := physicaltree.Build(device)
+	if len(tree.Orphans) != 1 || tree.Orphans[0].Id != "cycle-a" {
+		t.Fatalf("Expected the cycle to be broken at its lowest index member, got %v", tree.Orphans)
+	}
+	cycleB := tree.Orphans[0].Children[0]
+	if cycleB.Id != "cycle-b" || len(cycleB.Children) != 1 || cycleB.Children[0].Id != "port" || cycleB.Children[0].Depth != 2 {
+		t.Fatalf("Expected the port to stay below its parent, got %v", cycleB)
+	}
+}
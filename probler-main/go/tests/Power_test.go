@@ -0,0 +1,62 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/saichler/probler/go/services/power"
+	"github.com/saichler/probler/go/types"
+)
+
+func TestPowerSummary(t *testing.T) {
+	pdu := &types.PowerDevice{Id: "pdu1", Info: &types.PowerDeviceInfo{RackId: "r1"}, Pdu: &types.Pdu{
+		Circuits: map[string]*types.PowerCircuit{
+			"1": {CurrentDeciA: 130, RatedCurrentA: 16},
+			"2": {CurrentDeciA: 80, RatedCurrentA: 16}},
+		Outlets: map[string]*types.PduOutlet{
+			"1": {PowerW: 1200},
+			"2": {PowerW: 800}}}}
+	ups := &types.PowerDevice{Id: "ups1", Info: &types.PowerDeviceInfo{Name: "UPS A"}, Ups: &types.Ups{
+		InputPowerW: 3000, BatteryRuntimeMinutes: 25, OutputSource: types.UpsOutputSource_UPS_OUTPUT_SOURCE_BATTERY}}
+
+	summary := power.Summarize([]*types.PowerDevice{ups, pdu}, nil)
+	if summary.ItPowerW != 2000 || summary.FacilityPowerW != 3000 || summary.Pue != 1.5 {
+		t.Fatalf("Unexpected power totals %v", summary)
+	}
+	if len(summary.Circuits) != 2 || !summary.Circuits[0].Overloaded || summary.Circuits[1].Overloaded {
+		t.Fatalf("Expected only circuit 1 to be overloaded, got %v", summary.Circuits)
+	}
+	if summary.Circuits[0].CurrentA != 13 || summary.Circuits[0].LoadPercent != 81.25 || summary.Circuits[0].Name != "Phase 1" {
+		t.Fatalf("Expected the polled tenths of amps to be scaled, got %v", summary.Circuits[0])
+	}
+	if len(summary.Upses) != 1 || !summary.Upses[0].OnBattery || summary.Upses[0].BatteryRuntimeMinutes != 25 {
+		t.Fatalf("Unexpected ups runtime %v", summary.Upses)
+	}
+
+	summary = power.Summarize([]*types.PowerDevice{ups, pdu}, &types.PowerSummaryQuery{RackId: "r1"})
+	if summary.Pue != 0 || len(summary.Upses) != 0 || summary.ItPowerW != 2000 {
+		t.Fatalf("Expected only the rack PDU, got %v", summary)
+	}
+
+	pdu.Pdu.Outlets = nil
+	pdu.Pdu.Circuits["1"].PowerDecaW = 120
+	pdu.Pdu.Circuits["2"].PowerDecaW = 80
+	summary = power.Summarize([]*types.PowerDevice{pdu}, nil)
+	if summary.ItPowerW != 2000 {
+		t.Fatalf("Expected the polled hundredths of kW to be scaled to 2000W, got %v", summary.ItPowerW)
+	}
+}
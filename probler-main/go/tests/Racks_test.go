@@ -0,0 +1,72 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"math"
+	"testing"
+
+	"github.com/saichler/probler/go/services/racks"
+	"github.com/saichler/probler/go/types"
+)
+
+func TestRackPlacement(t *testing.T) {
+	rack := &types.Rack{Id: "r1", HeightU: 10, PowerBudgetW: 1000, Placements: []*types.RackPlacement{
+		{DeviceId: "sw1", PositionU: 10, HeightU: 1, PowerW: 200},
+		{DeviceId: "srv1", PositionU: 1, HeightU: 2, PowerW: 500},
+		{DeviceId: "pp1", PositionU: 9, HeightU: 1, Depth: types.RackDepth_RACK_DEPTH_HALF},
+		{DeviceId: "pp2", PositionU: 9, HeightU: 1, Depth: types.RackDepth_RACK_DEPTH_HALF, Face: types.RackFace_RACK_FACE_REAR},
+	}}
+	err := racks.Validate(rack)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	elevation := racks.Elevation(rack)
+	if len(elevation.Units) != 10 || elevation.UsedU != 4 || elevation.FreeU != 6 || elevation.PowerW != 700 {
+		t.Fatalf("Unexpected elevation %v", elevation)
+	}
+	if elevation.Units[0].Position != 10 || elevation.Units[0].FrontDeviceId != "sw1" || elevation.Units[0].RearDeviceId != "sw1" {
+		t.Fatalf("Expected sw1 at the top of the rack, got %v", elevation.Units[0])
+	}
+	if elevation.Units[1].FrontDeviceId != "pp1" || elevation.Units[1].RearDeviceId != "pp2" {
+		t.Fatalf("Expected the half depth devices back to back, got %v", elevation.Units[1])
+	}
+
+	rack.Placements = append(rack.Placements, &types.RackPlacement{DeviceId: "srv2", PositionU: 2, HeightU: 2})
+	if racks.Validate(rack) == nil {
+		t.Fatal("Expected srv2 to overlap srv1")
+	}
+	rack.Placements[4].PositionU = 3
+	rack.Placements[4].PowerW = 400
+	if racks.Validate(rack) == nil {
+		t.Fatal("Expected the power budget to be exceeded")
+	}
+	rack.Placements[4].PowerW = 0
+	rack.Placements[4].PositionU = 10
+	if racks.Validate(rack) == nil {
+		t.Fatal("Expected srv2 not to fit in the rack")
+	}
+	rack.Placements[4].PositionU = math.MaxUint32
+	if racks.Validate(rack) == nil {
+		t.Fatal("Expected srv2 not to fit in the rack at the overflowing position")
+	}
+	rack.Placements[4].PositionU = 9
+	rack.Placements[4].HeightU = 11
+	if racks.Validate(rack) == nil {
+		t.Fatal("Expected srv2 to be taller than the rack")
+	}
+}
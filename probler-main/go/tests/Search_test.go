@@ -0,0 +1,82 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/search"
+	"github.com/saichler/probler/go/types"
+)
+
+func searchDevice(id, sysName, serial string, interfaces ...*types.Interface) *types.NetworkDevice {
+	return &types.NetworkDevice{Id: id,
+		Equipmentinfo: &types.EquipmentInfo{SysName: sysName, SerialNumber: serial, IpAddress: id, Model: "ASR9K"},
+		Logicals:      map[string]*types.Logical{"0": {Id: "0", Interfaces: interfaces}},
+		Physicals: map[string]*types.Physical{"0": {Id: "0", Ports: []*types.Port{{Id: "p0",
+			Interfaces: append(interfaces, &types.Interface{Id: "mgmt0", Name: "mgmt0"})}}}}}
+}
+
+func TestSearch(t *testing.T) {
+	devices := []*types.NetworkDevice{
+		searchDevice("10.1.1.1", "core-rtr-01", "FOC1234X",
+			&types.Interface{Id: "ge1", Name: "GigabitEthernet0/1", Description: "uplink to dist-sw-07",
+				MacAddress: "00:1A:2B:3C:4D:5E", IpAddress: "192.168.7.1"}),
+		searchDevice("10.1.1.2", "dist-sw-07", "FOC9999Y"),
+	}
+	clusters := []*types.K8SCluster{{Name: "lab",
+		Pods: map[string]*types.K8SPod{"default/web-0": {Namespace: "default", Name: "web-0", Ip: "10.244.0.5"}}}}
+	if len(common.Interfaces(devices[0])) != 2 {
+		t.Fatalf("Expected the logical and the physical interfaces once, got %d", len(common.Interfaces(devices[0])))
+	}
+	index := search.NewIndex(devices, clusters)
+
+	result := index.Search(&types.SearchQuery{Text: "dist-sw-07"})
+	if len(result.Hits) != 2 || result.Hits[0].Kind != types.SearchKind_SEARCH_KIND_DEVICE || result.Hits[0].Id != "10.1.1.2" ||
+		result.Hits[1].Kind != types.SearchKind_SEARCH_KIND_INTERFACE || result.Hits[1].Field != "description" {
+		t.Fatalf("Expected the exact sys name first and the interface description second, got %v", result.Hits)
+	}
+
+	result = index.Search(&types.SearchQuery{Text: "001a.2b3c"})
+	if len(result.Hits) != 1 || result.Hits[0].Name != "GigabitEthernet0/1" || result.Hits[0].Field != "mac_address" {
+		t.Fatalf("Expected the mac to match regardless of its separators, got %v", result.Hits)
+	}
+
+	result = index.Search(&types.SearchQuery{Text: "core-rtr-10"})
+	if len(result.Hits) != 1 || result.Hits[0].Id != "10.1.1.1" || result.Hits[0].Field != "sys_name" {
+		t.Fatalf("Expected a fuzzy hit on the sys name, got %v", result.Hits)
+	}
+
+	result = index.Search(&types.SearchQuery{Text: "foc uplink"})
+	if len(result.Hits) != 0 {
+		t.Fatalf("Expected every word to match the same hit, got %v", result.Hits)
+	}
+
+	result = index.Search(&types.SearchQuery{Text: "web", Kinds: []types.SearchKind{types.SearchKind_SEARCH_KIND_K8S_POD}})
+	if len(result.Hits) != 1 || result.Hits[0].Id != "lab" || result.Hits[0].Name != "default/web-0" {
+		t.Fatalf("Expected the pod hit, got %v", result.Hits)
+	}
+
+	result = index.Search(&types.SearchQuery{Text: "10.1.1", Limit: 1})
+	if len(result.Hits) != 1 || result.Total != 2 {
+		t.Fatalf("Expected the hits to be limited, got %d of %d", len(result.Hits), result.Total)
+	}
+
+	if search.Distance("kitten", "sitting", 5) != 3 || search.Distance("kitten", "sitting", 1) != 2 {
+		t.Fatal("Unexpected edit distance")
+	}
+}
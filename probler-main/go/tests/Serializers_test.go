@@ -0,0 +1,66 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/saichler/probler/go/serializers"
+	"github.com/saichler/probler/go/types"
+)
+
+func TestParseAge(t *testing.T) {
+	ages := map[string]time.Duration{
+		"45s":   45 * time.Second,
+		"3h20m": 3*time.Hour + 20*time.Minute,
+		"5d3h":  5*24*time.Hour + 3*time.Hour,
+		"2y30d": 2*365*24*time.Hour + 30*24*time.Hour,
+	}
+	for str, expected := range ages {
+		d, ok := serializers.ParseAge(str)
+		if !ok || d != expected {
+			t.Fatalf("Expected %s to be %v, got %v", str, expected, d)
+		}
+	}
+	for _, str := range []string{"", "<unknown>", "5x", "d"} {
+		_, ok := serializers.ParseAge(str)
+		if ok {
+			t.Fatalf("Expected %s not to be parsed", str)
+		}
+	}
+}
+
+func TestAgeAndCountSerializers(t *testing.T) {
+	obj, _ := (&serializers.Age{}).Unmarshal([]byte("5d3h"), nil)
+	age := obj.(*types.K8SAge)
+	expected := time.Now().Add(-(5*24*time.Hour + 3*time.Hour)).Unix()
+	if age.Age != "5d3h" || age.Created < expected-5 || age.Created > expected {
+		t.Fatalf("Unexpected age %v", age)
+	}
+	//kubectl rounds the age, a poll a minute later must keep the creation time
+	first, _ := (&serializers.Age{}).Unmarshal([]byte("3h20m"), nil)
+	next, _ := (&serializers.Age{}).Unmarshal([]byte("3h21m"), nil)
+	later, _ := (&serializers.Age{}).Unmarshal([]byte("3h25m"), nil)
+	if next.(*types.K8SAge).Created != first.(*types.K8SAge).Created ||
+		later.(*types.K8SAge).Created == first.(*types.K8SAge).Created {
+		t.Fatalf("Expected only a rounding of the age to keep the creation time, got %v %v %v", first, next, later)
+	}
+	obj, _ = (&serializers.Count{}).Unmarshal([]byte(" 3 "), nil)
+	if obj.(*types.K8SCount).Count != 3 {
+		t.Fatalf("Unexpected count %v", obj)
+	}
+}
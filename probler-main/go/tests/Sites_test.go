@@ -0,0 +1,60 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/saichler/probler/go/services/sites"
+	"github.com/saichler/probler/go/types"
+)
+
+func siteDevice(id, location string, lat, lon float64, status types.DeviceStatus) *types.NetworkDevice {
+	return &types.NetworkDevice{Id: id, Equipmentinfo: &types.EquipmentInfo{Location: location,
+		Latitude: lat, Longitude: lon, DeviceStatus: status}}
+}
+
+func TestSiteSummary(t *testing.T) {
+	siteList := []*types.Site{
+		{Id: "nyc", Name: "New York", Latitude: 40.7128, Longitude: -74.0060, RadiusKm: 5, DeviceIds: []string{"d4"}},
+		{Id: "sfo", Name: "San Francisco", Latitude: 37.7749, Longitude: -122.4194, Locations: []string{"SF Lab"}},
+	}
+	devices := []*types.NetworkDevice{
+		siteDevice("d1", "", 40.7300, -74.0000, types.DeviceStatus_DEVICE_STATUS_ONLINE),
+		siteDevice("d2", "sf lab", 0, 0, types.DeviceStatus_DEVICE_STATUS_CRITICAL),
+		siteDevice("d3", "", 34.0522, -118.2437, types.DeviceStatus_DEVICE_STATUS_ONLINE),
+		siteDevice("d4", "sf lab", 37.7749, -122.4194, types.DeviceStatus_DEVICE_STATUS_OFFLINE),
+	}
+
+	summary := sites.Summarize(devices, siteList, nil)
+	if len(summary.List) != 2 || len(summary.UnassignedDeviceIds) != 1 || summary.UnassignedDeviceIds[0] != "d3" {
+		t.Fatalf("Expected 2 sites and d3 unassigned, got %v", summary)
+	}
+	nyc := summary.List[0]
+	if nyc.DeviceCount != 2 || nyc.OnlineCount != 1 || nyc.OfflineCount != 1 ||
+		nyc.AlarmSeverity != types.AlarmSeverity_ALARM_SEVERITY_MAJOR {
+		t.Fatalf("Unexpected nyc summary %v", nyc)
+	}
+	if nyc.Devices[0].Assignment != types.SiteAssignment_SITE_ASSIGNMENT_PROXIMITY ||
+		nyc.Devices[1].Assignment != types.SiteAssignment_SITE_ASSIGNMENT_EXPLICIT {
+		t.Fatalf("Unexpected nyc assignments %v", nyc.Devices)
+	}
+	sfo := summary.List[1]
+	if sfo.DeviceCount != 1 || sfo.AlarmSeverity != types.AlarmSeverity_ALARM_SEVERITY_CRITICAL ||
+		sfo.Devices[0].Assignment != types.SiteAssignment_SITE_ASSIGNMENT_LOCATION {
+		t.Fatalf("Unexpected sfo summary %v", sfo)
+	}
+}
@@ -0,0 +1,70 @@
+/*
+ * © 2025 Sharon Aicler (saichler@gmail.com)
+ *
+ * Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/saichler/l8pollaris/go/pollaris/targets"
+	"github.com/saichler/probler/go/prob/common"
+	"github.com/saichler/probler/go/services/conditions"
+	"github.com/saichler/probler/go/services/groups"
+	"github.com/saichler/probler/go/services/persist"
+	"github.com/saichler/probler/go/types"
+)
+
+func TestTags(t *testing.T) {
+	filters := persist.TagFilters("select * from NetworkDevice where tag.env=prod and tag.owner='netops' and Id=10.1.1.1")
+	if len(filters) != 2 || filters["env"] != "prod" || filters["owner"] != "netops" {
+		t.Fatalf("Unexpected tag filters %v", filters)
+	}
+	if len(persist.TagFilters("select * from NetworkDevice where Id=10.1.1.1")) != 0 {
+		t.Fatal("Expected no tag filters")
+	}
+
+	prod := &types.NetworkDevice{Id: "d1", Tags: map[string]string{"env": "prod", "owner": "netops"}}
+	lab := &types.NetworkDevice{Id: "d2", Tags: map[string]string{"env": "lab"}}
+	text := "select * from NetworkDevice where tag.env=prod and tag.owner='netops'"
+	where, err := conditions.Where(prod.ProtoReflect().Descriptor(), text)
+	if err != nil || !conditions.MatchAll(prod, where) || conditions.MatchAll(lab, where) {
+		t.Fatalf("Expected only d1 to have the tags %v", err)
+	}
+	if _, err = conditions.Where((&types.K8SCluster{}).ProtoReflect().Descriptor(),
+		"select * from K8SCluster where tag.env=prod"); err == nil {
+		t.Fatal("Expected a tag clause on an element type without tags to fail")
+	}
+	targets.Links = &common.Links{}
+	if name, _ := common.QueryService(common.NetworkDevice_Links_ID, text); name != common.NetDev_Persist_Service_Name {
+		t.Fatalf("Expected the persist service to serve the tag query, got %s", name)
+	}
+
+	if persist.ValidateTags(map[string]string{"contract-id": "C-42", "env": "prod"}, []string{"owner"}) != nil {
+		t.Fatal("Expected valid tags")
+	}
+	if persist.ValidateTags(map[string]string{"env prod": "x"}, nil) == nil ||
+		persist.ValidateTags(map[string]string{"env": ""}, nil) == nil ||
+		persist.ValidateTags(nil, []string{"tag.env"}) == nil {
+		t.Fatal("Expected invalid tags to be rejected")
+	}
+
+	query := &types.SavedQuery{Name: "prod", Target: types.GroupTarget_GROUP_TARGET_NETWORK_DEVICE,
+		Conditions: []*types.QueryCondition{
+			{Field: "tags.env", Operator: types.ConditionOperator_CONDITION_EQUALS, Value: "prod"}}}
+	members, err := groups.Evaluate(query, []*types.NetworkDevice{prod, lab}, nil, nil)
+	if err != nil || len(members.Ids) != 1 || members.Ids[0] != "d1" {
+		t.Fatalf("Expected only d1 in the prod group, got %v %v", members, err)
+	}
+}
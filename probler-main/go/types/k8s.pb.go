@@ -142,6 +142,247 @@ func (K8SNodeStatus) EnumDescriptor() ([]byte, []int) {
 	return file_k8s_proto_rawDescGZIP(), []int{1}
 }
 
+type K8SProblemSeverity int32
+
+const (
+	K8SProblemSeverity_Invalid_Problem_Severity K8SProblemSeverity = 0
+	K8SProblemSeverity_ProblemInfo              K8SProblemSeverity = 1
+	K8SProblemSeverity_ProblemWarning           K8SProblemSeverity = 2
+	K8SProblemSeverity_ProblemCritical          K8SProblemSeverity = 3
+)
+
+// Enum value maps for K8SProblemSeverity.
+var (
+	K8SProblemSeverity_name = map[int32]string{
+		0: "Invalid_Problem_Severity",
+		1: "ProblemInfo",
+		2: "ProblemWarning",
+		3: "ProblemCritical",
+	}
+	K8SProblemSeverity_value = map[string]int32{
+		"Invalid_Problem_Severity": 0,
+		"ProblemInfo":              1,
+		"ProblemWarning":           2,
+		"ProblemCritical":          3,
+	}
+)
+
+func (x K8SProblemSeverity) Enum() *K8SProblemSeverity {
+	p := new(K8SProblemSeverity)
+	*p = x
+	return p
+}
+
+func (x K8SProblemSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (K8SProblemSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_k8s_proto_enumTypes[2].Descriptor()
+}
+
+func (K8SProblemSeverity) Type() protoreflect.EnumType {
+	return &file_k8s_proto_enumTypes[2]
+}
+
+func (x K8SProblemSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use K8SProblemSeverity.Descriptor instead.
+func (K8SProblemSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{2}
+}
+
+type K8SProblemKind int32
+
+const (
+	K8SProblemKind_Invalid_Problem_Kind   K8SProblemKind = 0
+	K8SProblemKind_PodFailing             K8SProblemKind = 1
+	K8SProblemKind_PodPending             K8SProblemKind = 2
+	K8SProblemKind_DeploymentUnavailable  K8SProblemKind = 3
+	K8SProblemKind_StatefulSetUnavailable K8SProblemKind = 4
+	K8SProblemKind_DaemonSetMissing       K8SProblemKind = 5
+	K8SProblemKind_NodeNotReady           K8SProblemKind = 6
+	K8SProblemKind_RestartsRising         K8SProblemKind = 7
+)
+
+// Enum value maps for K8SProblemKind.
+var (
+	K8SProblemKind_name = map[int32]string{
+		0: "Invalid_Problem_Kind",
+		1: "PodFailing",
+		2: "PodPending",
+		3: "DeploymentUnavailable",
+		4: "StatefulSetUnavailable",
+		5: "DaemonSetMissing",
+		6: "NodeNotReady",
+		7: "RestartsRising",
+	}
+	K8SProblemKind_value = map[string]int32{
+		"Invalid_Problem_Kind":   0,
+		"PodFailing":             1,
+		"PodPending":             2,
+		"DeploymentUnavailable":  3,
+		"StatefulSetUnavailable": 4,
+		"DaemonSetMissing":       5,
+		"NodeNotReady":           6,
+		"RestartsRising":         7,
+	}
+)
+
+func (x K8SProblemKind) Enum() *K8SProblemKind {
+	p := new(K8SProblemKind)
+	*p = x
+	return p
+}
+
+func (x K8SProblemKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (K8SProblemKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_k8s_proto_enumTypes[3].Descriptor()
+}
+
+func (K8SProblemKind) Type() protoreflect.EnumType {
+	return &file_k8s_proto_enumTypes[3]
+}
+
+func (x K8SProblemKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use K8SProblemKind.Descriptor instead.
+func (K8SProblemKind) EnumDescriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{3}
+}
+
+type K8SPostureRule int32
+
+const (
+	K8SPostureRule_Invalid_Posture_Rule          K8SPostureRule = 0
+	K8SPostureRule_PrivilegedContainer           K8SPostureRule = 1
+	K8SPostureRule_RunAsRoot                     K8SPostureRule = 2
+	K8SPostureRule_HostNamespace                 K8SPostureRule = 3
+	K8SPostureRule_HostPathVolume                K8SPostureRule = 4
+	K8SPostureRule_WildcardRole                  K8SPostureRule = 5
+	K8SPostureRule_NamespaceWithoutNetworkPolicy K8SPostureRule = 6
+	K8SPostureRule_SecretInEnv                   K8SPostureRule = 7
+)
+
+// Enum value maps for K8SPostureRule.
+var (
+	K8SPostureRule_name = map[int32]string{
+		0: "Invalid_Posture_Rule",
+		1: "PrivilegedContainer",
+		2: "RunAsRoot",
+		3: "HostNamespace",
+		4: "HostPathVolume",
+		5: "WildcardRole",
+		6: "NamespaceWithoutNetworkPolicy",
+		7: "SecretInEnv",
+	}
+	K8SPostureRule_value = map[string]int32{
+		"Invalid_Posture_Rule":          0,
+		"PrivilegedContainer":           1,
+		"RunAsRoot":                     2,
+		"HostNamespace":                 3,
+		"HostPathVolume":                4,
+		"WildcardRole":                  5,
+		"NamespaceWithoutNetworkPolicy": 6,
+		"SecretInEnv":                   7,
+	}
+)
+
+func (x K8SPostureRule) Enum() *K8SPostureRule {
+	p := new(K8SPostureRule)
+	*p = x
+	return p
+}
+
+func (x K8SPostureRule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (K8SPostureRule) Descriptor() protoreflect.EnumDescriptor {
+	return file_k8s_proto_enumTypes[4].Descriptor()
+}
+
+func (K8SPostureRule) Type() protoreflect.EnumType {
+	return &file_k8s_proto_enumTypes[4]
+}
+
+func (x K8SPostureRule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use K8SPostureRule.Descriptor instead.
+func (K8SPostureRule) EnumDescriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{4}
+}
+
+type K8SDriftKind int32
+
+const (
+	K8SDriftKind_Invalid_Drift_Kind K8SDriftKind = 0
+	K8SDriftKind_OnlyInLeft         K8SDriftKind = 1
+	K8SDriftKind_OnlyInRight        K8SDriftKind = 2
+	K8SDriftKind_ImageDrift         K8SDriftKind = 3
+	K8SDriftKind_ReplicaDrift       K8SDriftKind = 4
+	K8SDriftKind_ServiceDrift       K8SDriftKind = 5
+	K8SDriftKind_NetworkPolicyDrift K8SDriftKind = 6
+)
+
+// Enum value maps for K8SDriftKind.
+var (
+	K8SDriftKind_name = map[int32]string{
+		0: "Invalid_Drift_Kind",
+		1: "OnlyInLeft",
+		2: "OnlyInRight",
+		3: "ImageDrift",
+		4: "ReplicaDrift",
+		5: "ServiceDrift",
+		6: "NetworkPolicyDrift",
+	}
+	K8SDriftKind_value = map[string]int32{
+		"Invalid_Drift_Kind": 0,
+		"OnlyInLeft":         1,
+		"OnlyInRight":        2,
+		"ImageDrift":         3,
+		"ReplicaDrift":       4,
+		"ServiceDrift":       5,
+		"NetworkPolicyDrift": 6,
+	}
+)
+
+func (x K8SDriftKind) Enum() *K8SDriftKind {
+	p := new(K8SDriftKind)
+	*p = x
+	return p
+}
+
+func (x K8SDriftKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (K8SDriftKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_k8s_proto_enumTypes[5].Descriptor()
+}
+
+func (K8SDriftKind) Type() protoreflect.EnumType {
+	return &file_k8s_proto_enumTypes[5]
+}
+
+func (x K8SDriftKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use K8SDriftKind.Descriptor instead.
+func (K8SDriftKind) EnumDescriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{5}
+}
+
 type K8SReadyState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -252,6 +493,108 @@ func (x *K8SRestartsState) GetAgo() string {
 	return ""
 }
 
+type K8SCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *K8SCount) Reset() {
+	*x = K8SCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SCount) ProtoMessage() {}
+
+func (x *K8SCount) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SCount.ProtoReflect.Descriptor instead.
+func (*K8SCount) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *K8SCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type K8SAge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Age     string `protobuf:"bytes,1,opt,name=age,proto3" json:"age,omitempty"`          // The age as shown by kubectl, e.g. 5d3h
+	Created int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"` // Creation time in unix seconds, computed from the age when it was first parsed
+}
+
+func (x *K8SAge) Reset() {
+	*x = K8SAge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SAge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SAge) ProtoMessage() {}
+
+func (x *K8SAge) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SAge.ProtoReflect.Descriptor instead.
+func (*K8SAge) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *K8SAge) GetAge() string {
+	if x != nil {
+		return x.Age
+	}
+	return ""
+}
+
+func (x *K8SAge) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
 type K8SClusterList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -263,7 +606,7 @@ type K8SClusterList struct {
 func (x *K8SClusterList) Reset() {
 	*x = K8SClusterList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_k8s_proto_msgTypes[2]
+		mi := &file_k8s_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -276,7 +619,7 @@ func (x *K8SClusterList) String() string {
 func (*K8SClusterList) ProtoMessage() {}
 
 func (x *K8SClusterList) ProtoReflect() protoreflect.Message {
-	mi := &file_k8s_proto_msgTypes[2]
+	mi := &file_k8s_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -289,7 +632,7 @@ func (x *K8SClusterList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use K8SClusterList.ProtoReflect.Descriptor instead.
 func (*K8SClusterList) Descriptor() ([]byte, []int) {
-	return file_k8s_proto_rawDescGZIP(), []int{2}
+	return file_k8s_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *K8SClusterList) GetList() []*K8SCluster {
@@ -313,12 +656,13 @@ type K8SCluster struct {
 	Services        map[string]*K8SService       `protobuf:"bytes,7,rep,name=services,proto3" json:"services,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Namespaces      map[string]*K8SNamespace     `protobuf:"bytes,8,rep,name=namespaces,proto3" json:"namespaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Networkpolicies map[string]*K8SNetworkPolicy `protobuf:"bytes,9,rep,name=networkpolicies,proto3" json:"networkpolicies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Objects         *K8SObjects                  `protobuf:"bytes,10,opt,name=objects,proto3" json:"objects,omitempty"`
 }
 
 func (x *K8SCluster) Reset() {
 	*x = K8SCluster{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_k8s_proto_msgTypes[3]
+		mi := &file_k8s_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -331,7 +675,7 @@ func (x *K8SCluster) String() string {
 func (*K8SCluster) ProtoMessage() {}
 
 func (x *K8SCluster) ProtoReflect() protoreflect.Message {
-	mi := &file_k8s_proto_msgTypes[3]
+	mi := &file_k8s_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,7 +688,7 @@ func (x *K8SCluster) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use K8SCluster.ProtoReflect.Descriptor instead.
 func (*K8SCluster) Descriptor() ([]byte, []int) {
-	return file_k8s_proto_rawDescGZIP(), []int{3}
+	return file_k8s_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *K8SCluster) GetName() string {
@@ -410,40 +754,62 @@ func (x *K8SCluster) GetNetworkpolicies() map[string]*K8SNetworkPolicy {
 	return nil
 }
 
-type K8SPod struct {
+func (x *K8SCluster) GetObjects() *K8SObjects {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+// The full API objects of a cluster, mapped from kubectl get -o json.
+// Namespaced objects are keyed by <namespace>/<name>, cluster scoped objects by their name.
+type K8SObjects struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Namespace      string            `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name           string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Ready          *K8SReadyState    `protobuf:"bytes,3,opt,name=ready,proto3" json:"ready,omitempty"`
-	Status         K8SPodStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=types.K8SPodStatus" json:"status,omitempty"`
-	Restarts       *K8SRestartsState `protobuf:"bytes,5,opt,name=restarts,proto3" json:"restarts,omitempty"`
-	Age            string            `protobuf:"bytes,6,opt,name=age,proto3" json:"age,omitempty"`
-	Ip             string            `protobuf:"bytes,7,opt,name=ip,proto3" json:"ip,omitempty"`
-	Node           string            `protobuf:"bytes,8,opt,name=node,proto3" json:"node,omitempty"`
-	NominatedNode  string            `protobuf:"bytes,9,opt,name=nominated_node,json=nominatedNode,proto3" json:"nominated_node,omitempty"`
-	ReadinessGates string            `protobuf:"bytes,10,opt,name=readiness_gates,json=readinessGates,proto3" json:"readiness_gates,omitempty"`
-}
-
-func (x *K8SPod) Reset() {
-	*x = K8SPod{}
+	Pods                   map[string]*Pod                   `protobuf:"bytes,1,rep,name=pods,proto3" json:"pods,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Deployments            map[string]*Deployment            `protobuf:"bytes,2,rep,name=deployments,proto3" json:"deployments,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Statefulsets           map[string]*StatefulSet           `protobuf:"bytes,3,rep,name=statefulsets,proto3" json:"statefulsets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Daemonsets             map[string]*DaemonSet             `protobuf:"bytes,4,rep,name=daemonsets,proto3" json:"daemonsets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Services               map[string]*Service               `protobuf:"bytes,5,rep,name=services,proto3" json:"services,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Ingresses              map[string]*Ingress               `protobuf:"bytes,6,rep,name=ingresses,proto3" json:"ingresses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Nodes                  map[string]*Node                  `protobuf:"bytes,7,rep,name=nodes,proto3" json:"nodes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Namespaces             map[string]*Namespace             `protobuf:"bytes,8,rep,name=namespaces,proto3" json:"namespaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Networkpolicies        map[string]*NetworkPolicy         `protobuf:"bytes,9,rep,name=networkpolicies,proto3" json:"networkpolicies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Persistentvolumes      map[string]*PersistentVolume      `protobuf:"bytes,10,rep,name=persistentvolumes,proto3" json:"persistentvolumes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Roles                  map[string]*Role                  `protobuf:"bytes,11,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Clusterroles           map[string]*ClusterRole           `protobuf:"bytes,12,rep,name=clusterroles,proto3" json:"clusterroles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Rolebindings           map[string]*RoleBinding           `protobuf:"bytes,13,rep,name=rolebindings,proto3" json:"rolebindings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Clusterrolebindings    map[string]*ClusterRoleBinding    `protobuf:"bytes,14,rep,name=clusterrolebindings,proto3" json:"clusterrolebindings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Collected              int64                             `protobuf:"varint,16,opt,name=collected,proto3" json:"collected,omitempty"` // Unix seconds of the last collection
+	Replicasets            map[string]*ReplicaSet            `protobuf:"bytes,17,rep,name=replicasets,proto3" json:"replicasets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Jobs                   map[string]*Job                   `protobuf:"bytes,18,rep,name=jobs,proto3" json:"jobs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Cronjobs               map[string]*CronJob               `protobuf:"bytes,19,rep,name=cronjobs,proto3" json:"cronjobs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Endpoints              map[string]*Endpoints             `protobuf:"bytes,20,rep,name=endpoints,proto3" json:"endpoints,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Persistentvolumeclaims map[string]*PersistentVolumeClaim `protobuf:"bytes,21,rep,name=persistentvolumeclaims,proto3" json:"persistentvolumeclaims,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Storageclasses         map[string]*StorageClass          `protobuf:"bytes,22,rep,name=storageclasses,proto3" json:"storageclasses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Configmaps             map[string]*ConfigMap             `protobuf:"bytes,23,rep,name=configmaps,proto3" json:"configmaps,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Metadata only, the data is never collected
+	Events                 map[string]*Event                 `protobuf:"bytes,24,rep,name=events,proto3" json:"events,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *K8SObjects) Reset() {
+	*x = K8SObjects{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_k8s_proto_msgTypes[4]
+		mi := &file_k8s_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *K8SPod) String() string {
+func (x *K8SObjects) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*K8SPod) ProtoMessage() {}
+func (*K8SObjects) ProtoMessage() {}
 
-func (x *K8SPod) ProtoReflect() protoreflect.Message {
-	mi := &file_k8s_proto_msgTypes[4]
+func (x *K8SObjects) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,233 +820,200 @@ func (x *K8SPod) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use K8SPod.ProtoReflect.Descriptor instead.
-func (*K8SPod) Descriptor() ([]byte, []int) {
-	return file_k8s_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use K8SObjects.ProtoReflect.Descriptor instead.
+func (*K8SObjects) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *K8SPod) GetNamespace() string {
+func (x *K8SObjects) GetPods() map[string]*Pod {
 	if x != nil {
-		return x.Namespace
+		return x.Pods
 	}
-	return ""
+	return nil
 }
 
-func (x *K8SPod) GetName() string {
+func (x *K8SObjects) GetDeployments() map[string]*Deployment {
 	if x != nil {
-		return x.Name
+		return x.Deployments
 	}
-	return ""
+	return nil
 }
 
-func (x *K8SPod) GetReady() *K8SReadyState {
+func (x *K8SObjects) GetStatefulsets() map[string]*StatefulSet {
 	if x != nil {
-		return x.Ready
+		return x.Statefulsets
 	}
 	return nil
 }
 
-func (x *K8SPod) GetStatus() K8SPodStatus {
+func (x *K8SObjects) GetDaemonsets() map[string]*DaemonSet {
 	if x != nil {
-		return x.Status
+		return x.Daemonsets
 	}
-	return K8SPodStatus_Invalid_Pod_Status
+	return nil
 }
 
-func (x *K8SPod) GetRestarts() *K8SRestartsState {
+func (x *K8SObjects) GetServices() map[string]*Service {
 	if x != nil {
-		return x.Restarts
+		return x.Services
 	}
 	return nil
 }
 
-func (x *K8SPod) GetAge() string {
+func (x *K8SObjects) GetIngresses() map[string]*Ingress {
 	if x != nil {
-		return x.Age
+		return x.Ingresses
 	}
-	return ""
+	return nil
 }
 
-func (x *K8SPod) GetIp() string {
+func (x *K8SObjects) GetNodes() map[string]*Node {
 	if x != nil {
-		return x.Ip
+		return x.Nodes
 	}
-	return ""
+	return nil
 }
 
-func (x *K8SPod) GetNode() string {
+func (x *K8SObjects) GetNamespaces() map[string]*Namespace {
 	if x != nil {
-		return x.Node
+		return x.Namespaces
 	}
-	return ""
+	return nil
 }
 
-func (x *K8SPod) GetNominatedNode() string {
+func (x *K8SObjects) GetNetworkpolicies() map[string]*NetworkPolicy {
 	if x != nil {
-		return x.NominatedNode
+		return x.Networkpolicies
 	}
-	return ""
+	return nil
 }
 
-func (x *K8SPod) GetReadinessGates() string {
+func (x *K8SObjects) GetPersistentvolumes() map[string]*PersistentVolume {
 	if x != nil {
-		return x.ReadinessGates
+		return x.Persistentvolumes
 	}
-	return ""
-}
-
-type K8SNode struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name             string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Status           K8SNodeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=types.K8SNodeStatus" json:"status,omitempty"`
-	Roles            string        `protobuf:"bytes,3,opt,name=roles,proto3" json:"roles,omitempty"`
-	Age              string        `protobuf:"bytes,4,opt,name=age,proto3" json:"age,omitempty"`
-	Version          string        `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
-	InternalIp       string        `protobuf:"bytes,6,opt,name=internal_ip,json=internalIp,proto3" json:"internal_ip,omitempty"`
-	ExternalIp       string        `protobuf:"bytes,7,opt,name=external_ip,json=externalIp,proto3" json:"external_ip,omitempty"`
-	OsImage          string        `protobuf:"bytes,8,opt,name=os_image,json=osImage,proto3" json:"os_image,omitempty"`
-	KernelVersion    string        `protobuf:"bytes,9,opt,name=kernel_version,json=kernelVersion,proto3" json:"kernel_version,omitempty"`
-	ContainerRuntime string        `protobuf:"bytes,10,opt,name=container_runtime,json=containerRuntime,proto3" json:"container_runtime,omitempty"`
+	return nil
 }
 
-func (x *K8SNode) Reset() {
-	*x = K8SNode{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_k8s_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *K8SObjects) GetRoles() map[string]*Role {
+	if x != nil {
+		return x.Roles
 	}
+	return nil
 }
 
-func (x *K8SNode) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*K8SNode) ProtoMessage() {}
-
-func (x *K8SNode) ProtoReflect() protoreflect.Message {
-	mi := &file_k8s_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *K8SObjects) GetClusterroles() map[string]*ClusterRole {
+	if x != nil {
+		return x.Clusterroles
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use K8SNode.ProtoReflect.Descriptor instead.
-func (*K8SNode) Descriptor() ([]byte, []int) {
-	return file_k8s_proto_rawDescGZIP(), []int{5}
+func (x *K8SObjects) GetRolebindings() map[string]*RoleBinding {
+	if x != nil {
+		return x.Rolebindings
+	}
+	return nil
 }
 
-func (x *K8SNode) GetName() string {
+func (x *K8SObjects) GetClusterrolebindings() map[string]*ClusterRoleBinding {
 	if x != nil {
-		return x.Name
+		return x.Clusterrolebindings
 	}
-	return ""
+	return nil
 }
 
-func (x *K8SNode) GetStatus() K8SNodeStatus {
+func (x *K8SObjects) GetCollected() int64 {
 	if x != nil {
-		return x.Status
+		return x.Collected
 	}
-	return K8SNodeStatus_Invalid_Node_Status
+	return 0
 }
 
-func (x *K8SNode) GetRoles() string {
+func (x *K8SObjects) GetReplicasets() map[string]*ReplicaSet {
 	if x != nil {
-		return x.Roles
+		return x.Replicasets
 	}
-	return ""
+	return nil
 }
 
-func (x *K8SNode) GetAge() string {
+func (x *K8SObjects) GetJobs() map[string]*Job {
 	if x != nil {
-		return x.Age
+		return x.Jobs
 	}
-	return ""
+	return nil
 }
 
-func (x *K8SNode) GetVersion() string {
+func (x *K8SObjects) GetCronjobs() map[string]*CronJob {
 	if x != nil {
-		return x.Version
+		return x.Cronjobs
 	}
-	return ""
+	return nil
 }
 
-func (x *K8SNode) GetInternalIp() string {
+func (x *K8SObjects) GetEndpoints() map[string]*Endpoints {
 	if x != nil {
-		return x.InternalIp
+		return x.Endpoints
 	}
-	return ""
+	return nil
 }
 
-func (x *K8SNode) GetExternalIp() string {
+func (x *K8SObjects) GetPersistentvolumeclaims() map[string]*PersistentVolumeClaim {
 	if x != nil {
-		return x.ExternalIp
+		return x.Persistentvolumeclaims
 	}
-	return ""
+	return nil
 }
 
-func (x *K8SNode) GetOsImage() string {
+func (x *K8SObjects) GetStorageclasses() map[string]*StorageClass {
 	if x != nil {
-		return x.OsImage
+		return x.Storageclasses
 	}
-	return ""
+	return nil
 }
 
-func (x *K8SNode) GetKernelVersion() string {
+func (x *K8SObjects) GetConfigmaps() map[string]*ConfigMap {
 	if x != nil {
-		return x.KernelVersion
+		return x.Configmaps
 	}
-	return ""
+	return nil
 }
 
-func (x *K8SNode) GetContainerRuntime() string {
+func (x *K8SObjects) GetEvents() map[string]*Event {
 	if x != nil {
-		return x.ContainerRuntime
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-type K8SDeployment struct {
+type K8SContainerQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Ready      string `protobuf:"bytes,3,opt,name=ready,proto3" json:"ready,omitempty"`
-	UpToDate   string `protobuf:"bytes,4,opt,name=up_to_date,json=upToDate,proto3" json:"up_to_date,omitempty"`
-	Available  string `protobuf:"bytes,5,opt,name=available,proto3" json:"available,omitempty"`
-	Age        string `protobuf:"bytes,6,opt,name=age,proto3" json:"age,omitempty"`
-	Containers string `protobuf:"bytes,7,opt,name=containers,proto3" json:"containers,omitempty"`
-	Images     string `protobuf:"bytes,8,opt,name=images,proto3" json:"images,omitempty"`
-	Selector   string `protobuf:"bytes,9,opt,name=selector,proto3" json:"selector,omitempty"`
+	Cluster        string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"` // Empty for all the clusters
+	Namespace      string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	MissingLimit   string `protobuf:"bytes,3,opt,name=missing_limit,json=missingLimit,proto3" json:"missing_limit,omitempty"`       // Only containers without this limit, e.g. memory or cpu
+	MissingRequest string `protobuf:"bytes,4,opt,name=missing_request,json=missingRequest,proto3" json:"missing_request,omitempty"` // Only containers without this request
 }
 
-func (x *K8SDeployment) Reset() {
-	*x = K8SDeployment{}
+func (x *K8SContainerQuery) Reset() {
+	*x = K8SContainerQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_k8s_proto_msgTypes[6]
+		mi := &file_k8s_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *K8SDeployment) String() string {
+func (x *K8SContainerQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*K8SDeployment) ProtoMessage() {}
+func (*K8SContainerQuery) ProtoMessage() {}
 
-func (x *K8SDeployment) ProtoReflect() protoreflect.Message {
-	mi := &file_k8s_proto_msgTypes[6]
+func (x *K8SContainerQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,104 +1024,118 @@ func (x *K8SDeployment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use K8SDeployment.ProtoReflect.Descriptor instead.
-func (*K8SDeployment) Descriptor() ([]byte, []int) {
-	return file_k8s_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use K8SContainerQuery.ProtoReflect.Descriptor instead.
+func (*K8SContainerQuery) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *K8SDeployment) GetNamespace() string {
+func (x *K8SContainerQuery) GetCluster() string {
 	if x != nil {
-		return x.Namespace
+		return x.Cluster
 	}
 	return ""
 }
 
-func (x *K8SDeployment) GetName() string {
+func (x *K8SContainerQuery) GetNamespace() string {
 	if x != nil {
-		return x.Name
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *K8SDeployment) GetReady() string {
+func (x *K8SContainerQuery) GetMissingLimit() string {
 	if x != nil {
-		return x.Ready
+		return x.MissingLimit
 	}
 	return ""
 }
 
-func (x *K8SDeployment) GetUpToDate() string {
+func (x *K8SContainerQuery) GetMissingRequest() string {
 	if x != nil {
-		return x.UpToDate
+		return x.MissingRequest
 	}
 	return ""
 }
 
-func (x *K8SDeployment) GetAvailable() string {
-	if x != nil {
-		return x.Available
-	}
-	return ""
+type K8SContainerList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*K8SContainer `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 }
 
-func (x *K8SDeployment) GetAge() string {
-	if x != nil {
-		return x.Age
+func (x *K8SContainerList) Reset() {
+	*x = K8SContainerList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *K8SDeployment) GetContainers() string {
-	if x != nil {
-		return x.Containers
-	}
-	return ""
+func (x *K8SContainerList) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *K8SDeployment) GetImages() string {
-	if x != nil {
-		return x.Images
+func (*K8SContainerList) ProtoMessage() {}
+
+func (x *K8SContainerList) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *K8SDeployment) GetSelector() string {
+// Deprecated: Use K8SContainerList.ProtoReflect.Descriptor instead.
+func (*K8SContainerList) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *K8SContainerList) GetList() []*K8SContainer {
 	if x != nil {
-		return x.Selector
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-type K8SStatefulSet struct {
+type K8SContainer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Ready      string `protobuf:"bytes,3,opt,name=ready,proto3" json:"ready,omitempty"`
-	Age        string `protobuf:"bytes,4,opt,name=age,proto3" json:"age,omitempty"`
-	Containers string `protobuf:"bytes,5,opt,name=containers,proto3" json:"containers,omitempty"`
-	Images     string `protobuf:"bytes,6,opt,name=images,proto3" json:"images,omitempty"`
+	Cluster   string            `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Namespace string            `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Pod       string            `protobuf:"bytes,3,opt,name=pod,proto3" json:"pod,omitempty"`
+	Name      string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Image     string            `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	Init      bool              `protobuf:"varint,6,opt,name=init,proto3" json:"init,omitempty"`
+	Limits    map[string]string `protobuf:"bytes,7,rep,name=limits,proto3" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Requests  map[string]string `protobuf:"bytes,8,rep,name=requests,proto3" json:"requests,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *K8SStatefulSet) Reset() {
-	*x = K8SStatefulSet{}
+func (x *K8SContainer) Reset() {
+	*x = K8SContainer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_k8s_proto_msgTypes[7]
+		mi := &file_k8s_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *K8SStatefulSet) String() string {
+func (x *K8SContainer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*K8SStatefulSet) ProtoMessage() {}
+func (*K8SContainer) ProtoMessage() {}
 
-func (x *K8SStatefulSet) ProtoReflect() protoreflect.Message {
-	mi := &file_k8s_proto_msgTypes[7]
+func (x *K8SContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -799,89 +1146,98 @@ func (x *K8SStatefulSet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use K8SStatefulSet.ProtoReflect.Descriptor instead.
-func (*K8SStatefulSet) Descriptor() ([]byte, []int) {
-	return file_k8s_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use K8SContainer.ProtoReflect.Descriptor instead.
+func (*K8SContainer) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *K8SStatefulSet) GetNamespace() string {
+func (x *K8SContainer) GetCluster() string {
 	if x != nil {
-		return x.Namespace
+		return x.Cluster
 	}
 	return ""
 }
 
-func (x *K8SStatefulSet) GetName() string {
+func (x *K8SContainer) GetNamespace() string {
 	if x != nil {
-		return x.Name
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *K8SStatefulSet) GetReady() string {
+func (x *K8SContainer) GetPod() string {
 	if x != nil {
-		return x.Ready
+		return x.Pod
 	}
 	return ""
 }
 
-func (x *K8SStatefulSet) GetAge() string {
+func (x *K8SContainer) GetName() string {
 	if x != nil {
-		return x.Age
+		return x.Name
 	}
 	return ""
 }
 
-func (x *K8SStatefulSet) GetContainers() string {
+func (x *K8SContainer) GetImage() string {
 	if x != nil {
-		return x.Containers
+		return x.Image
 	}
 	return ""
 }
 
-func (x *K8SStatefulSet) GetImages() string {
+func (x *K8SContainer) GetInit() bool {
 	if x != nil {
-		return x.Images
+		return x.Init
 	}
-	return ""
+	return false
 }
 
-type K8SDaemonSet struct {
+func (x *K8SContainer) GetLimits() map[string]string {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *K8SContainer) GetRequests() map[string]string {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type K8SEventQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Namespace    string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Desired      string `protobuf:"bytes,3,opt,name=desired,proto3" json:"desired,omitempty"`
-	Current      string `protobuf:"bytes,4,opt,name=current,proto3" json:"current,omitempty"`
-	Ready        string `protobuf:"bytes,5,opt,name=ready,proto3" json:"ready,omitempty"`
-	UpToDate     string `protobuf:"bytes,6,opt,name=up_to_date,json=upToDate,proto3" json:"up_to_date,omitempty"`
-	Available    string `protobuf:"bytes,7,opt,name=available,proto3" json:"available,omitempty"`
-	NodeSelector string `protobuf:"bytes,8,opt,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty"`
-	Age          string `protobuf:"bytes,9,opt,name=age,proto3" json:"age,omitempty"`
-	Containers   string `protobuf:"bytes,10,opt,name=containers,proto3" json:"containers,omitempty"`
-	Images       string `protobuf:"bytes,11,opt,name=images,proto3" json:"images,omitempty"`
-	Selector     string `protobuf:"bytes,12,opt,name=selector,proto3" json:"selector,omitempty"`
+	Cluster   string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"` // Empty for all the clusters
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Object    string `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"` // Name of the pod, node or workload the events are linked to
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"` // e.g. BackOff
+	Type      string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`     // e.g. Warning
+	Since     int64  `protobuf:"varint,6,opt,name=since,proto3" json:"since,omitempty"`  // Unix seconds, only events last seen since then
+	Limit     int32  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`  // Most recent events first, 0 for all
 }
 
-func (x *K8SDaemonSet) Reset() {
-	*x = K8SDaemonSet{}
+func (x *K8SEventQuery) Reset() {
+	*x = K8SEventQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_k8s_proto_msgTypes[8]
+		mi := &file_k8s_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *K8SDaemonSet) String() string {
+func (x *K8SEventQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*K8SDaemonSet) ProtoMessage() {}
+func (*K8SEventQuery) ProtoMessage() {}
 
-func (x *K8SDaemonSet) ProtoReflect() protoreflect.Message {
-	mi := &file_k8s_proto_msgTypes[8]
+func (x *K8SEventQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -892,127 +1248,147 @@ func (x *K8SDaemonSet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use K8SDaemonSet.ProtoReflect.Descriptor instead.
-func (*K8SDaemonSet) Descriptor() ([]byte, []int) {
-	return file_k8s_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use K8SEventQuery.ProtoReflect.Descriptor instead.
+func (*K8SEventQuery) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *K8SDaemonSet) GetNamespace() string {
+func (x *K8SEventQuery) GetCluster() string {
 	if x != nil {
-		return x.Namespace
+		return x.Cluster
 	}
 	return ""
 }
 
-func (x *K8SDaemonSet) GetName() string {
+func (x *K8SEventQuery) GetNamespace() string {
 	if x != nil {
-		return x.Name
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *K8SDaemonSet) GetDesired() string {
+func (x *K8SEventQuery) GetObject() string {
 	if x != nil {
-		return x.Desired
+		return x.Object
 	}
 	return ""
 }
 
-func (x *K8SDaemonSet) GetCurrent() string {
+func (x *K8SEventQuery) GetReason() string {
 	if x != nil {
-		return x.Current
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *K8SDaemonSet) GetReady() string {
+func (x *K8SEventQuery) GetType() string {
 	if x != nil {
-		return x.Ready
+		return x.Type
 	}
 	return ""
 }
 
-func (x *K8SDaemonSet) GetUpToDate() string {
+func (x *K8SEventQuery) GetSince() int64 {
 	if x != nil {
-		return x.UpToDate
+		return x.Since
 	}
-	return ""
+	return 0
 }
 
-func (x *K8SDaemonSet) GetAvailable() string {
+func (x *K8SEventQuery) GetLimit() int32 {
 	if x != nil {
-		return x.Available
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *K8SDaemonSet) GetNodeSelector() string {
-	if x != nil {
-		return x.NodeSelector
-	}
-	return ""
-}
+type K8SEventList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *K8SDaemonSet) GetAge() string {
-	if x != nil {
-		return x.Age
-	}
-	return ""
+	List []*K8SEvent `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 }
 
-func (x *K8SDaemonSet) GetContainers() string {
-	if x != nil {
-		return x.Containers
+func (x *K8SEventList) Reset() {
+	*x = K8SEventList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *K8SDaemonSet) GetImages() string {
-	if x != nil {
-		return x.Images
-	}
-	return ""
+func (x *K8SEventList) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *K8SDaemonSet) GetSelector() string {
+func (*K8SEventList) ProtoMessage() {}
+
+func (x *K8SEventList) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SEventList.ProtoReflect.Descriptor instead.
+func (*K8SEventList) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *K8SEventList) GetList() []*K8SEvent {
 	if x != nil {
-		return x.Selector
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-type K8SService struct {
+// A cluster event linked to the pod, node and workload it references.
+type K8SEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type       string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	ClusterIp  string `protobuf:"bytes,4,opt,name=cluster_ip,json=clusterIp,proto3" json:"cluster_ip,omitempty"`
-	ExternalIp string `protobuf:"bytes,5,opt,name=external_ip,json=externalIp,proto3" json:"external_ip,omitempty"`
-	Ports      string `protobuf:"bytes,6,opt,name=ports,proto3" json:"ports,omitempty"`
-	Age        string `protobuf:"bytes,7,opt,name=age,proto3" json:"age,omitempty"`
-	Selector   string `protobuf:"bytes,8,opt,name=selector,proto3" json:"selector,omitempty"`
-}
-
-func (x *K8SService) Reset() {
-	*x = K8SService{}
+	Cluster      string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Key          string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"` // <namespace>/<name> of the event object
+	Namespace    string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Type         string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Reason       string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message      string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Count        int32  `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	FirstSeen    int64  `protobuf:"varint,8,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"` // Unix seconds
+	LastSeen     int64  `protobuf:"varint,9,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`    // Unix seconds
+	ObjectKind   string `protobuf:"bytes,10,opt,name=object_kind,json=objectKind,proto3" json:"object_kind,omitempty"`
+	ObjectName   string `protobuf:"bytes,11,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	Pod          string `protobuf:"bytes,12,opt,name=pod,proto3" json:"pod,omitempty"`
+	Node         string `protobuf:"bytes,13,opt,name=node,proto3" json:"node,omitempty"`
+	WorkloadKind string `protobuf:"bytes,14,opt,name=workload_kind,json=workloadKind,proto3" json:"workload_kind,omitempty"` // The top owner of a pod, e.g. Deployment rather than ReplicaSet
+	WorkloadName string `protobuf:"bytes,15,opt,name=workload_name,json=workloadName,proto3" json:"workload_name,omitempty"`
+}
+
+func (x *K8SEvent) Reset() {
+	*x = K8SEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_k8s_proto_msgTypes[9]
+		mi := &file_k8s_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *K8SService) String() string {
+func (x *K8SEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*K8SService) ProtoMessage() {}
+func (*K8SEvent) ProtoMessage() {}
 
-func (x *K8SService) ProtoReflect() protoreflect.Message {
-	mi := &file_k8s_proto_msgTypes[9]
+func (x *K8SEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1023,94 +1399,142 @@ func (x *K8SService) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use K8SService.ProtoReflect.Descriptor instead.
-func (*K8SService) Descriptor() ([]byte, []int) {
-	return file_k8s_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use K8SEvent.ProtoReflect.Descriptor instead.
+func (*K8SEvent) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *K8SService) GetNamespace() string {
+func (x *K8SEvent) GetCluster() string {
 	if x != nil {
-		return x.Namespace
+		return x.Cluster
 	}
 	return ""
 }
 
-func (x *K8SService) GetName() string {
+func (x *K8SEvent) GetKey() string {
 	if x != nil {
-		return x.Name
+		return x.Key
 	}
 	return ""
 }
 
-func (x *K8SService) GetType() string {
+func (x *K8SEvent) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *K8SEvent) GetType() string {
 	if x != nil {
 		return x.Type
 	}
 	return ""
 }
 
-func (x *K8SService) GetClusterIp() string {
+func (x *K8SEvent) GetReason() string {
 	if x != nil {
-		return x.ClusterIp
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *K8SService) GetExternalIp() string {
+func (x *K8SEvent) GetMessage() string {
 	if x != nil {
-		return x.ExternalIp
+		return x.Message
 	}
 	return ""
 }
 
-func (x *K8SService) GetPorts() string {
+func (x *K8SEvent) GetCount() int32 {
 	if x != nil {
-		return x.Ports
+		return x.Count
+	}
+	return 0
+}
+
+func (x *K8SEvent) GetFirstSeen() int64 {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return 0
+}
+
+func (x *K8SEvent) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *K8SEvent) GetObjectKind() string {
+	if x != nil {
+		return x.ObjectKind
 	}
 	return ""
 }
 
-func (x *K8SService) GetAge() string {
+func (x *K8SEvent) GetObjectName() string {
 	if x != nil {
-		return x.Age
+		return x.ObjectName
 	}
 	return ""
 }
 
-func (x *K8SService) GetSelector() string {
+func (x *K8SEvent) GetPod() string {
 	if x != nil {
-		return x.Selector
+		return x.Pod
 	}
 	return ""
 }
 
-type K8SNamespace struct {
+func (x *K8SEvent) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *K8SEvent) GetWorkloadKind() string {
+	if x != nil {
+		return x.WorkloadKind
+	}
+	return ""
+}
+
+func (x *K8SEvent) GetWorkloadName() string {
+	if x != nil {
+		return x.WorkloadName
+	}
+	return ""
+}
+
+type K8SDoctorQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Age    string `protobuf:"bytes,3,opt,name=age,proto3" json:"age,omitempty"`
+	Cluster        string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`                                      // Empty for all the clusters
+	PendingMinutes int32  `protobuf:"varint,2,opt,name=pending_minutes,json=pendingMinutes,proto3" json:"pending_minutes,omitempty"` // Pods pending longer than this are reported, 0 for the default
 }
 
-func (x *K8SNamespace) Reset() {
-	*x = K8SNamespace{}
+func (x *K8SDoctorQuery) Reset() {
+	*x = K8SDoctorQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_k8s_proto_msgTypes[10]
+		mi := &file_k8s_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *K8SNamespace) String() string {
+func (x *K8SDoctorQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*K8SNamespace) ProtoMessage() {}
+func (*K8SDoctorQuery) ProtoMessage() {}
 
-func (x *K8SNamespace) ProtoReflect() protoreflect.Message {
-	mi := &file_k8s_proto_msgTypes[10]
+func (x *K8SDoctorQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1121,60 +1545,102 @@ func (x *K8SNamespace) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use K8SNamespace.ProtoReflect.Descriptor instead.
-func (*K8SNamespace) Descriptor() ([]byte, []int) {
-	return file_k8s_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use K8SDoctorQuery.ProtoReflect.Descriptor instead.
+func (*K8SDoctorQuery) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *K8SNamespace) GetName() string {
+func (x *K8SDoctorQuery) GetCluster() string {
 	if x != nil {
-		return x.Name
+		return x.Cluster
 	}
 	return ""
 }
 
-func (x *K8SNamespace) GetStatus() string {
+func (x *K8SDoctorQuery) GetPendingMinutes() int32 {
 	if x != nil {
-		return x.Status
+		return x.PendingMinutes
 	}
-	return ""
+	return 0
+}
+
+type K8SProblemReportList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*K8SProblemReport `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *K8SProblemReportList) Reset() {
+	*x = K8SProblemReportList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SProblemReportList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SProblemReportList) ProtoMessage() {}
+
+func (x *K8SProblemReportList) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *K8SNamespace) GetAge() string {
+// Deprecated: Use K8SProblemReportList.ProtoReflect.Descriptor instead.
+func (*K8SProblemReportList) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *K8SProblemReportList) GetList() []*K8SProblemReport {
 	if x != nil {
-		return x.Age
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-type K8SNetworkPolicy struct {
+type K8SProblemReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Namespace   string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	PodSelector string `protobuf:"bytes,3,opt,name=pod_selector,json=podSelector,proto3" json:"pod_selector,omitempty"`
-	Age         string `protobuf:"bytes,4,opt,name=age,proto3" json:"age,omitempty"`
+	Cluster       string        `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Analyzed      int64         `protobuf:"varint,2,opt,name=analyzed,proto3" json:"analyzed,omitempty"` // Unix seconds
+	CriticalCount int32         `protobuf:"varint,3,opt,name=critical_count,json=criticalCount,proto3" json:"critical_count,omitempty"`
+	WarningCount  int32         `protobuf:"varint,4,opt,name=warning_count,json=warningCount,proto3" json:"warning_count,omitempty"`
+	InfoCount     int32         `protobuf:"varint,5,opt,name=info_count,json=infoCount,proto3" json:"info_count,omitempty"`
+	Problems      []*K8SProblem `protobuf:"bytes,6,rep,name=problems,proto3" json:"problems,omitempty"` // The most severe first
 }
 
-func (x *K8SNetworkPolicy) Reset() {
-	*x = K8SNetworkPolicy{}
+func (x *K8SProblemReport) Reset() {
+	*x = K8SProblemReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_k8s_proto_msgTypes[11]
+		mi := &file_k8s_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *K8SNetworkPolicy) String() string {
+func (x *K8SProblemReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*K8SNetworkPolicy) ProtoMessage() {}
+func (*K8SProblemReport) ProtoMessage() {}
 
-func (x *K8SNetworkPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_k8s_proto_msgTypes[11]
+func (x *K8SProblemReport) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1185,377 +1651,3003 @@ func (x *K8SNetworkPolicy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use K8SNetworkPolicy.ProtoReflect.Descriptor instead.
-func (*K8SNetworkPolicy) Descriptor() ([]byte, []int) {
-	return file_k8s_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use K8SProblemReport.ProtoReflect.Descriptor instead.
+func (*K8SProblemReport) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *K8SNetworkPolicy) GetNamespace() string {
+func (x *K8SProblemReport) GetCluster() string {
 	if x != nil {
-		return x.Namespace
+		return x.Cluster
 	}
 	return ""
 }
 
-func (x *K8SNetworkPolicy) GetName() string {
+func (x *K8SProblemReport) GetAnalyzed() int64 {
 	if x != nil {
-		return x.Name
+		return x.Analyzed
 	}
-	return ""
+	return 0
 }
 
-func (x *K8SNetworkPolicy) GetPodSelector() string {
+func (x *K8SProblemReport) GetCriticalCount() int32 {
 	if x != nil {
-		return x.PodSelector
+		return x.CriticalCount
 	}
-	return ""
+	return 0
 }
 
-func (x *K8SNetworkPolicy) GetAge() string {
+func (x *K8SProblemReport) GetWarningCount() int32 {
 	if x != nil {
-		return x.Age
+		return x.WarningCount
 	}
-	return ""
+	return 0
 }
 
-var File_k8s_proto protoreflect.FileDescriptor
+func (x *K8SProblemReport) GetInfoCount() int32 {
+	if x != nil {
+		return x.InfoCount
+	}
+	return 0
+}
 
-var file_k8s_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x6b, 0x38, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x22, 0x3b, 0x0a, 0x0d, 0x4b, 0x38, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x75, 0x74,
-	0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x75, 0x74, 0x6f, 0x66, 0x22,
-	0x3a, 0x0a, 0x10, 0x4b, 0x38, 0x73, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x6f,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x67, 0x6f, 0x22, 0x37, 0x0a, 0x0e, 0x4b,
-	0x38, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x0a,
-	0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x04,
-	0x6c, 0x69, 0x73, 0x74, 0x22, 0xbe, 0x09, 0x0a, 0x0a, 0x4b, 0x38, 0x73, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b,
-	0x38, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x70,
-	0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x64,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x70, 0x6f, 0x64, 0x73, 0x12, 0x44, 0x0a, 0x0b,
-	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x73, 0x65,
-	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x66, 0x75, 0x6c, 0x73, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x73, 0x65, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x64,
-	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x2e, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0a, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x73, 0x12, 0x3b,
-	0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x50,
-	0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
-	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x4b, 0x38, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
-	0x1a, 0x48, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46, 0x0a, 0x09, 0x50, 0x6f,
-	0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x4b, 0x38, 0x73, 0x50, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x54, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x4b, 0x38, 0x73, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x56, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x66, 0x75, 0x6c, 0x73, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66,
-	0x75, 0x6c, 0x53, 0x65, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x52, 0x0a, 0x0f, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73,
-	0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4e, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b,
-	0x38, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x52, 0x0a, 0x0f, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x4b, 0x38, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5b, 0x0a, 0x14, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xce, 0x02, 0x0a, 0x06, 0x4b, 0x38, 0x73, 0x50, 0x6f, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x52, 0x65, 0x61,
-	0x64, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x2b,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x50, 0x6f, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73,
-	0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61,
-	0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a,
-	0x0f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x73,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73,
-	0x73, 0x47, 0x61, 0x74, 0x65, 0x73, 0x22, 0xbe, 0x02, 0x0a, 0x07, 0x4b, 0x38, 0x73, 0x4e, 0x6f,
-	0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b,
-	0x38, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x5f, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x73, 0x5f, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x73, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x65, 0x72,
-	0x6e, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xf9, 0x01, 0x0a, 0x0d, 0x4b, 0x38, 0x73, 0x44,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72,
-	0x65, 0x61, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64,
-	0x79, 0x12, 0x1c, 0x0a, 0x0a, 0x75, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x54, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x22, 0xa2, 0x01, 0x0a, 0x0e, 0x4b, 0x38, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x67, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x22, 0xd1, 0x02, 0x0a, 0x0c, 0x4b, 0x38, 0x73,
-	0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64,
-	0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65,
-	0x73, 0x69, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x1c, 0x0a, 0x0a, 0x75, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x54, 0x6f, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xd6, 0x01, 0x0a,
-	0x0a, 0x4b, 0x38, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x70,
-	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x70, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49,
-	0x70, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x4c, 0x0a, 0x0c, 0x4b, 0x38, 0x73, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x61, 0x67, 0x65, 0x22, 0x79, 0x0a, 0x10, 0x4b, 0x38, 0x73, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x64,
-	0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x70, 0x6f, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03,
-	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x67, 0x65, 0x2a, 0xd6,
-	0x01, 0x0a, 0x0c, 0x4b, 0x38, 0x73, 0x50, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x16, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x50, 0x6f, 0x64, 0x5f, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10,
-	0x02, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x10, 0x03,
-	0x12, 0x0a, 0x0a, 0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07,
-	0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x72, 0x61,
-	0x73, 0x68, 0x4c, 0x6f, 0x6f, 0x70, 0x42, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x10, 0x06, 0x12,
-	0x0f, 0x0a, 0x0b, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x07,
-	0x12, 0x15, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x50, 0x75, 0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x10, 0x09, 0x12, 0x09, 0x0a,
-	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x0a, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x10, 0x0b, 0x2a, 0x33, 0x0a, 0x0d, 0x4b, 0x38, 0x73, 0x4e, 0x6f,
-	0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x5f, 0x4e, 0x6f, 0x64, 0x65, 0x5f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10,
-	0x00, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x64, 0x79, 0x10, 0x01, 0x42, 0x21, 0x0a, 0x0d,
-	0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x38, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x05, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x50, 0x01, 0x5a, 0x07, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *K8SProblemReport) GetProblems() []*K8SProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
 }
 
-var (
-	file_k8s_proto_rawDescOnce sync.Once
-	file_k8s_proto_rawDescData = file_k8s_proto_rawDesc
-)
+type K8SProblem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_k8s_proto_rawDescGZIP() []byte {
-	file_k8s_proto_rawDescOnce.Do(func() {
-		file_k8s_proto_rawDescData = protoimpl.X.CompressGZIP(file_k8s_proto_rawDescData)
-	})
-	return file_k8s_proto_rawDescData
+	Severity   K8SProblemSeverity `protobuf:"varint,1,opt,name=severity,proto3,enum=types.K8SProblemSeverity" json:"severity,omitempty"`
+	Kind       K8SProblemKind     `protobuf:"varint,2,opt,name=kind,proto3,enum=types.K8SProblemKind" json:"kind,omitempty"`
+	Namespace  string             `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ObjectKind string             `protobuf:"bytes,4,opt,name=object_kind,json=objectKind,proto3" json:"object_kind,omitempty"`
+	Name       string             `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Summary    string             `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+	NextSteps  []string           `protobuf:"bytes,7,rep,name=next_steps,json=nextSteps,proto3" json:"next_steps,omitempty"`
 }
 
-var file_k8s_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_k8s_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
-var file_k8s_proto_goTypes = []interface{}{
-	(K8SPodStatus)(0),        // 0: types.K8sPodStatus
-	(K8SNodeStatus)(0),       // 1: types.K8sNodeStatus
-	(*K8SReadyState)(nil),    // 2: types.K8sReadyState
-	(*K8SRestartsState)(nil), // 3: types.K8sRestartsState
-	(*K8SClusterList)(nil),   // 4: types.K8sClusterList
-	(*K8SCluster)(nil),       // 5: types.K8sCluster
-	(*K8SPod)(nil),           // 6: types.K8sPod
-	(*K8SNode)(nil),          // 7: types.K8sNode
-	(*K8SDeployment)(nil),    // 8: types.K8sDeployment
-	(*K8SStatefulSet)(nil),   // 9: types.K8sStatefulSet
-	(*K8SDaemonSet)(nil),     // 10: types.K8sDaemonSet
-	(*K8SService)(nil),       // 11: types.K8sService
-	(*K8SNamespace)(nil),     // 12: types.K8sNamespace
-	(*K8SNetworkPolicy)(nil), // 13: types.K8sNetworkPolicy
-	nil,                      // 14: types.K8sCluster.NodesEntry
-	nil,                      // 15: types.K8sCluster.PodsEntry
-	nil,                      // 16: types.K8sCluster.DeploymentsEntry
-	nil,                      // 17: types.K8sCluster.StatefulsetsEntry
-	nil,                      // 18: types.K8sCluster.DaemonsetsEntry
-	nil,                      // 19: types.K8sCluster.ServicesEntry
-	nil,                      // 20: types.K8sCluster.NamespacesEntry
-	nil,                      // 21: types.K8sCluster.NetworkpoliciesEntry
+func (x *K8SProblem) Reset() {
+	*x = K8SProblem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_k8s_proto_depIdxs = []int32{
-	5,  // 0: types.K8sClusterList.list:type_name -> types.K8sCluster
-	14, // 1: types.K8sCluster.nodes:type_name -> types.K8sCluster.NodesEntry
-	15, // 2: types.K8sCluster.pods:type_name -> types.K8sCluster.PodsEntry
-	16, // 3: types.K8sCluster.deployments:type_name -> types.K8sCluster.DeploymentsEntry
-	17, // 4: types.K8sCluster.statefulsets:type_name -> types.K8sCluster.StatefulsetsEntry
-	18, // 5: types.K8sCluster.daemonsets:type_name -> types.K8sCluster.DaemonsetsEntry
-	19, // 6: types.K8sCluster.services:type_name -> types.K8sCluster.ServicesEntry
-	20, // 7: types.K8sCluster.namespaces:type_name -> types.K8sCluster.NamespacesEntry
-	21, // 8: types.K8sCluster.networkpolicies:type_name -> types.K8sCluster.NetworkpoliciesEntry
-	2,  // 9: types.K8sPod.ready:type_name -> types.K8sReadyState
-	0,  // 10: types.K8sPod.status:type_name -> types.K8sPodStatus
-	3,  // 11: types.K8sPod.restarts:type_name -> types.K8sRestartsState
-	1,  // 12: types.K8sNode.status:type_name -> types.K8sNodeStatus
-	7,  // 13: types.K8sCluster.NodesEntry.value:type_name -> types.K8sNode
-	6,  // 14: types.K8sCluster.PodsEntry.value:type_name -> types.K8sPod
-	8,  // 15: types.K8sCluster.DeploymentsEntry.value:type_name -> types.K8sDeployment
-	9,  // 16: types.K8sCluster.StatefulsetsEntry.value:type_name -> types.K8sStatefulSet
-	10, // 17: types.K8sCluster.DaemonsetsEntry.value:type_name -> types.K8sDaemonSet
-	11, // 18: types.K8sCluster.ServicesEntry.value:type_name -> types.K8sService
-	12, // 19: types.K8sCluster.NamespacesEntry.value:type_name -> types.K8sNamespace
-	13, // 20: types.K8sCluster.NetworkpoliciesEntry.value:type_name -> types.K8sNetworkPolicy
-	21, // [21:21] is the sub-list for method output_type
-	21, // [21:21] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+
+func (x *K8SProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_k8s_proto_init() }
-func file_k8s_proto_init() {
-	if File_k8s_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_k8s_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*K8SReadyState); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_k8s_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*K8SRestartsState); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_k8s_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*K8SClusterList); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+func (*K8SProblem) ProtoMessage() {}
+
+func (x *K8SProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_k8s_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SProblem.ProtoReflect.Descriptor instead.
+func (*K8SProblem) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *K8SProblem) GetSeverity() K8SProblemSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return K8SProblemSeverity_Invalid_Problem_Severity
+}
+
+func (x *K8SProblem) GetKind() K8SProblemKind {
+	if x != nil {
+		return x.Kind
+	}
+	return K8SProblemKind_Invalid_Problem_Kind
+}
+
+func (x *K8SProblem) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *K8SProblem) GetObjectKind() string {
+	if x != nil {
+		return x.ObjectKind
+	}
+	return ""
+}
+
+func (x *K8SProblem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *K8SProblem) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *K8SProblem) GetNextSteps() []string {
+	if x != nil {
+		return x.NextSteps
+	}
+	return nil
+}
+
+type K8SPostureQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cluster   string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`     // Empty for all the clusters
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Empty for all the namespaces
+}
+
+func (x *K8SPostureQuery) Reset() {
+	*x = K8SPostureQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SPostureQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SPostureQuery) ProtoMessage() {}
+
+func (x *K8SPostureQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SPostureQuery.ProtoReflect.Descriptor instead.
+func (*K8SPostureQuery) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *K8SPostureQuery) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *K8SPostureQuery) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type K8SPostureReportList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*K8SPostureReport `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *K8SPostureReportList) Reset() {
+	*x = K8SPostureReportList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SPostureReportList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SPostureReportList) ProtoMessage() {}
+
+func (x *K8SPostureReportList) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SPostureReportList.ProtoReflect.Descriptor instead.
+func (*K8SPostureReportList) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *K8SPostureReportList) GetList() []*K8SPostureReport {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type K8SPostureReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cluster         string                  `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Scanned         int64                   `protobuf:"varint,2,opt,name=scanned,proto3" json:"scanned,omitempty"` // Unix seconds
+	CriticalCount   int32                   `protobuf:"varint,3,opt,name=critical_count,json=criticalCount,proto3" json:"critical_count,omitempty"`
+	WarningCount    int32                   `protobuf:"varint,4,opt,name=warning_count,json=warningCount,proto3" json:"warning_count,omitempty"`
+	InfoCount       int32                   `protobuf:"varint,5,opt,name=info_count,json=infoCount,proto3" json:"info_count,omitempty"`
+	NamespaceCounts map[string]int32        `protobuf:"bytes,6,rep,name=namespace_counts,json=namespaceCounts,proto3" json:"namespace_counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Empty for the cluster scoped objects
+	Findings        []*K8SPostureFinding    `protobuf:"bytes,7,rep,name=findings,proto3" json:"findings,omitempty"`                                                                                                                               // The most severe first
+	Trend           []*K8SPostureTrendPoint `protobuf:"bytes,8,rep,name=trend,proto3" json:"trend,omitempty"`                                                                                                                                     // The periodic scans of the cluster, oldest first
+}
+
+func (x *K8SPostureReport) Reset() {
+	*x = K8SPostureReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SPostureReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SPostureReport) ProtoMessage() {}
+
+func (x *K8SPostureReport) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SPostureReport.ProtoReflect.Descriptor instead.
+func (*K8SPostureReport) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *K8SPostureReport) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *K8SPostureReport) GetScanned() int64 {
+	if x != nil {
+		return x.Scanned
+	}
+	return 0
+}
+
+func (x *K8SPostureReport) GetCriticalCount() int32 {
+	if x != nil {
+		return x.CriticalCount
+	}
+	return 0
+}
+
+func (x *K8SPostureReport) GetWarningCount() int32 {
+	if x != nil {
+		return x.WarningCount
+	}
+	return 0
+}
+
+func (x *K8SPostureReport) GetInfoCount() int32 {
+	if x != nil {
+		return x.InfoCount
+	}
+	return 0
+}
+
+func (x *K8SPostureReport) GetNamespaceCounts() map[string]int32 {
+	if x != nil {
+		return x.NamespaceCounts
+	}
+	return nil
+}
+
+func (x *K8SPostureReport) GetFindings() []*K8SPostureFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *K8SPostureReport) GetTrend() []*K8SPostureTrendPoint {
+	if x != nil {
+		return x.Trend
+	}
+	return nil
+}
+
+type K8SPostureFinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule       K8SPostureRule     `protobuf:"varint,1,opt,name=rule,proto3,enum=types.K8SPostureRule" json:"rule,omitempty"`
+	Severity   K8SProblemSeverity `protobuf:"varint,2,opt,name=severity,proto3,enum=types.K8SProblemSeverity" json:"severity,omitempty"`
+	Namespace  string             `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ObjectKind string             `protobuf:"bytes,4,opt,name=object_kind,json=objectKind,proto3" json:"object_kind,omitempty"`
+	Name       string             `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Container  string             `protobuf:"bytes,6,opt,name=container,proto3" json:"container,omitempty"`
+	Detail     string             `protobuf:"bytes,7,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *K8SPostureFinding) Reset() {
+	*x = K8SPostureFinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SPostureFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SPostureFinding) ProtoMessage() {}
+
+func (x *K8SPostureFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SPostureFinding.ProtoReflect.Descriptor instead.
+func (*K8SPostureFinding) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *K8SPostureFinding) GetRule() K8SPostureRule {
+	if x != nil {
+		return x.Rule
+	}
+	return K8SPostureRule_Invalid_Posture_Rule
+}
+
+func (x *K8SPostureFinding) GetSeverity() K8SProblemSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return K8SProblemSeverity_Invalid_Problem_Severity
+}
+
+func (x *K8SPostureFinding) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *K8SPostureFinding) GetObjectKind() string {
+	if x != nil {
+		return x.ObjectKind
+	}
+	return ""
+}
+
+func (x *K8SPostureFinding) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *K8SPostureFinding) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *K8SPostureFinding) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type K8SPostureTrendPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time          int64            `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	CriticalCount int32            `protobuf:"varint,2,opt,name=critical_count,json=criticalCount,proto3" json:"critical_count,omitempty"`
+	WarningCount  int32            `protobuf:"varint,3,opt,name=warning_count,json=warningCount,proto3" json:"warning_count,omitempty"`
+	InfoCount     int32            `protobuf:"varint,4,opt,name=info_count,json=infoCount,proto3" json:"info_count,omitempty"`
+	RuleCounts    map[string]int32 `protobuf:"bytes,5,rep,name=rule_counts,json=ruleCounts,proto3" json:"rule_counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Keyed by the rule name
+}
+
+func (x *K8SPostureTrendPoint) Reset() {
+	*x = K8SPostureTrendPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SPostureTrendPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SPostureTrendPoint) ProtoMessage() {}
+
+func (x *K8SPostureTrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SPostureTrendPoint.ProtoReflect.Descriptor instead.
+func (*K8SPostureTrendPoint) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *K8SPostureTrendPoint) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *K8SPostureTrendPoint) GetCriticalCount() int32 {
+	if x != nil {
+		return x.CriticalCount
+	}
+	return 0
+}
+
+func (x *K8SPostureTrendPoint) GetWarningCount() int32 {
+	if x != nil {
+		return x.WarningCount
+	}
+	return 0
+}
+
+func (x *K8SPostureTrendPoint) GetInfoCount() int32 {
+	if x != nil {
+		return x.InfoCount
+	}
+	return 0
+}
+
+func (x *K8SPostureTrendPoint) GetRuleCounts() map[string]int32 {
+	if x != nil {
+		return x.RuleCounts
+	}
+	return nil
+}
+
+// Compares two clusters, or two namespaces. The right namespace defaults to the left namespace,
+// so the same namespace of two clusters is compared by setting just the left one.
+type K8SDriftQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeftCluster    string `protobuf:"bytes,1,opt,name=left_cluster,json=leftCluster,proto3" json:"left_cluster,omitempty"`
+	LeftNamespace  string `protobuf:"bytes,2,opt,name=left_namespace,json=leftNamespace,proto3" json:"left_namespace,omitempty"`
+	RightCluster   string `protobuf:"bytes,3,opt,name=right_cluster,json=rightCluster,proto3" json:"right_cluster,omitempty"`
+	RightNamespace string `protobuf:"bytes,4,opt,name=right_namespace,json=rightNamespace,proto3" json:"right_namespace,omitempty"`
+}
+
+func (x *K8SDriftQuery) Reset() {
+	*x = K8SDriftQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SDriftQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SDriftQuery) ProtoMessage() {}
+
+func (x *K8SDriftQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SDriftQuery.ProtoReflect.Descriptor instead.
+func (*K8SDriftQuery) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *K8SDriftQuery) GetLeftCluster() string {
+	if x != nil {
+		return x.LeftCluster
+	}
+	return ""
+}
+
+func (x *K8SDriftQuery) GetLeftNamespace() string {
+	if x != nil {
+		return x.LeftNamespace
+	}
+	return ""
+}
+
+func (x *K8SDriftQuery) GetRightCluster() string {
+	if x != nil {
+		return x.RightCluster
+	}
+	return ""
+}
+
+func (x *K8SDriftQuery) GetRightNamespace() string {
+	if x != nil {
+		return x.RightNamespace
+	}
+	return ""
+}
+
+type K8SDrift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Left       string          `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"` // <cluster> or <cluster>/<namespace>
+	Right      string          `protobuf:"bytes,2,opt,name=right,proto3" json:"right,omitempty"`
+	MatchCount int32           `protobuf:"varint,3,opt,name=match_count,json=matchCount,proto3" json:"match_count,omitempty"`
+	Items      []*K8SDriftItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *K8SDrift) Reset() {
+	*x = K8SDrift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SDrift) ProtoMessage() {}
+
+func (x *K8SDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SDrift.ProtoReflect.Descriptor instead.
+func (*K8SDrift) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *K8SDrift) GetLeft() string {
+	if x != nil {
+		return x.Left
+	}
+	return ""
+}
+
+func (x *K8SDrift) GetRight() string {
+	if x != nil {
+		return x.Right
+	}
+	return ""
+}
+
+func (x *K8SDrift) GetMatchCount() int32 {
+	if x != nil {
+		return x.MatchCount
+	}
+	return 0
+}
+
+func (x *K8SDrift) GetItems() []*K8SDriftItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// A difference of an object, which is named <namespace>/<name> when whole clusters are compared.
+type K8SDriftItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind       K8SDriftKind `protobuf:"varint,1,opt,name=kind,proto3,enum=types.K8SDriftKind" json:"kind,omitempty"`
+	ObjectKind string       `protobuf:"bytes,2,opt,name=object_kind,json=objectKind,proto3" json:"object_kind,omitempty"`
+	Name       string       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Left       string       `protobuf:"bytes,4,opt,name=left,proto3" json:"left,omitempty"` // The left value, e.g. the images or the replica count
+	Right      string       `protobuf:"bytes,5,opt,name=right,proto3" json:"right,omitempty"`
+}
+
+func (x *K8SDriftItem) Reset() {
+	*x = K8SDriftItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SDriftItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SDriftItem) ProtoMessage() {}
+
+func (x *K8SDriftItem) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SDriftItem.ProtoReflect.Descriptor instead.
+func (*K8SDriftItem) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *K8SDriftItem) GetKind() K8SDriftKind {
+	if x != nil {
+		return x.Kind
+	}
+	return K8SDriftKind_Invalid_Drift_Kind
+}
+
+func (x *K8SDriftItem) GetObjectKind() string {
+	if x != nil {
+		return x.ObjectKind
+	}
+	return ""
+}
+
+func (x *K8SDriftItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *K8SDriftItem) GetLeft() string {
+	if x != nil {
+		return x.Left
+	}
+	return ""
+}
+
+func (x *K8SDriftItem) GetRight() string {
+	if x != nil {
+		return x.Right
+	}
+	return ""
+}
+
+// The pod size the headroom is computed for, in kubernetes quantities. The default is 100m and 128Mi.
+type K8SCapacityQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cluster   string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`                      // Empty for all the clusters
+	PodCpu    string `protobuf:"bytes,2,opt,name=pod_cpu,json=podCpu,proto3" json:"pod_cpu,omitempty"`          // e.g. 500m
+	PodMemory string `protobuf:"bytes,3,opt,name=pod_memory,json=podMemory,proto3" json:"pod_memory,omitempty"` // e.g. 512Mi
+}
+
+func (x *K8SCapacityQuery) Reset() {
+	*x = K8SCapacityQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SCapacityQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SCapacityQuery) ProtoMessage() {}
+
+func (x *K8SCapacityQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SCapacityQuery.ProtoReflect.Descriptor instead.
+func (*K8SCapacityQuery) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *K8SCapacityQuery) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *K8SCapacityQuery) GetPodCpu() string {
+	if x != nil {
+		return x.PodCpu
+	}
+	return ""
+}
+
+func (x *K8SCapacityQuery) GetPodMemory() string {
+	if x != nil {
+		return x.PodMemory
+	}
+	return ""
+}
+
+type K8SCapacityReportList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*K8SCapacityReport `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *K8SCapacityReportList) Reset() {
+	*x = K8SCapacityReportList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SCapacityReportList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SCapacityReportList) ProtoMessage() {}
+
+func (x *K8SCapacityReportList) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SCapacityReportList.ProtoReflect.Descriptor instead.
+func (*K8SCapacityReportList) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *K8SCapacityReportList) GetList() []*K8SCapacityReport {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type K8SCapacityReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cluster   string         `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	PodCpu    string         `protobuf:"bytes,2,opt,name=pod_cpu,json=podCpu,proto3" json:"pod_cpu,omitempty"`
+	PodMemory string         `protobuf:"bytes,3,opt,name=pod_memory,json=podMemory,proto3" json:"pod_memory,omitempty"`
+	Total     *K8SCapacity   `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"` // The sum of the schedulable nodes
+	Nodes     []*K8SCapacity `protobuf:"bytes,5,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *K8SCapacityReport) Reset() {
+	*x = K8SCapacityReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SCapacityReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SCapacityReport) ProtoMessage() {}
+
+func (x *K8SCapacityReport) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SCapacityReport.ProtoReflect.Descriptor instead.
+func (*K8SCapacityReport) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *K8SCapacityReport) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *K8SCapacityReport) GetPodCpu() string {
+	if x != nil {
+		return x.PodCpu
+	}
+	return ""
+}
+
+func (x *K8SCapacityReport) GetPodMemory() string {
+	if x != nil {
+		return x.PodMemory
+	}
+	return ""
+}
+
+func (x *K8SCapacityReport) GetTotal() *K8SCapacity {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *K8SCapacityReport) GetNodes() []*K8SCapacity {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+// The requested & limited resources of the pods on a node against its allocatable resources.
+// CPU is in millicores and memory in bytes, the ratios are of the allocatable resources.
+type K8SCapacity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name               string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Schedulable        bool    `protobuf:"varint,2,opt,name=schedulable,proto3" json:"schedulable,omitempty"`
+	AllocatableCpu     int64   `protobuf:"varint,3,opt,name=allocatable_cpu,json=allocatableCpu,proto3" json:"allocatable_cpu,omitempty"`
+	RequestedCpu       int64   `protobuf:"varint,4,opt,name=requested_cpu,json=requestedCpu,proto3" json:"requested_cpu,omitempty"`
+	LimitCpu           int64   `protobuf:"varint,5,opt,name=limit_cpu,json=limitCpu,proto3" json:"limit_cpu,omitempty"`
+	AllocatableMemory  int64   `protobuf:"varint,6,opt,name=allocatable_memory,json=allocatableMemory,proto3" json:"allocatable_memory,omitempty"`
+	RequestedMemory    int64   `protobuf:"varint,7,opt,name=requested_memory,json=requestedMemory,proto3" json:"requested_memory,omitempty"`
+	LimitMemory        int64   `protobuf:"varint,8,opt,name=limit_memory,json=limitMemory,proto3" json:"limit_memory,omitempty"`
+	AllocatablePods    int32   `protobuf:"varint,9,opt,name=allocatable_pods,json=allocatablePods,proto3" json:"allocatable_pods,omitempty"`
+	PodCount           int32   `protobuf:"varint,10,opt,name=pod_count,json=podCount,proto3" json:"pod_count,omitempty"`
+	CpuRequestRatio    float64 `protobuf:"fixed64,11,opt,name=cpu_request_ratio,json=cpuRequestRatio,proto3" json:"cpu_request_ratio,omitempty"`
+	MemoryRequestRatio float64 `protobuf:"fixed64,12,opt,name=memory_request_ratio,json=memoryRequestRatio,proto3" json:"memory_request_ratio,omitempty"`
+	CpuOvercommit      float64 `protobuf:"fixed64,13,opt,name=cpu_overcommit,json=cpuOvercommit,proto3" json:"cpu_overcommit,omitempty"` // Limits to allocatable
+	MemoryOvercommit   float64 `protobuf:"fixed64,14,opt,name=memory_overcommit,json=memoryOvercommit,proto3" json:"memory_overcommit,omitempty"`
+	PodsFit            int32   `protobuf:"varint,15,opt,name=pods_fit,json=podsFit,proto3" json:"pods_fit,omitempty"`                              // How many more pods of the queried size fit
+	PodsFitUnbounded   bool    `protobuf:"varint,16,opt,name=pods_fit_unbounded,json=podsFitUnbounded,proto3" json:"pods_fit_unbounded,omitempty"` // No pod slot, cpu or memory limit applies, the pods fit is 0
+}
+
+func (x *K8SCapacity) Reset() {
+	*x = K8SCapacity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SCapacity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SCapacity) ProtoMessage() {}
+
+func (x *K8SCapacity) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SCapacity.ProtoReflect.Descriptor instead.
+func (*K8SCapacity) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *K8SCapacity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *K8SCapacity) GetSchedulable() bool {
+	if x != nil {
+		return x.Schedulable
+	}
+	return false
+}
+
+func (x *K8SCapacity) GetAllocatableCpu() int64 {
+	if x != nil {
+		return x.AllocatableCpu
+	}
+	return 0
+}
+
+func (x *K8SCapacity) GetRequestedCpu() int64 {
+	if x != nil {
+		return x.RequestedCpu
+	}
+	return 0
+}
+
+func (x *K8SCapacity) GetLimitCpu() int64 {
+	if x != nil {
+		return x.LimitCpu
+	}
+	return 0
+}
+
+func (x *K8SCapacity) GetAllocatableMemory() int64 {
+	if x != nil {
+		return x.AllocatableMemory
+	}
+	return 0
+}
+
+func (x *K8SCapacity) GetRequestedMemory() int64 {
+	if x != nil {
+		return x.RequestedMemory
+	}
+	return 0
+}
+
+func (x *K8SCapacity) GetLimitMemory() int64 {
+	if x != nil {
+		return x.LimitMemory
+	}
+	return 0
+}
+
+func (x *K8SCapacity) GetAllocatablePods() int32 {
+	if x != nil {
+		return x.AllocatablePods
+	}
+	return 0
+}
+
+func (x *K8SCapacity) GetPodCount() int32 {
+	if x != nil {
+		return x.PodCount
+	}
+	return 0
+}
+
+func (x *K8SCapacity) GetCpuRequestRatio() float64 {
+	if x != nil {
+		return x.CpuRequestRatio
+	}
+	return 0
+}
+
+func (x *K8SCapacity) GetMemoryRequestRatio() float64 {
+	if x != nil {
+		return x.MemoryRequestRatio
+	}
+	return 0
+}
+
+func (x *K8SCapacity) GetCpuOvercommit() float64 {
+	if x != nil {
+		return x.CpuOvercommit
+	}
+	return 0
+}
+
+func (x *K8SCapacity) GetMemoryOvercommit() float64 {
+	if x != nil {
+		return x.MemoryOvercommit
+	}
+	return 0
+}
+
+func (x *K8SCapacity) GetPodsFit() int32 {
+	if x != nil {
+		return x.PodsFit
+	}
+	return 0
+}
+
+func (x *K8SCapacity) GetPodsFitUnbounded() bool {
+	if x != nil {
+		return x.PodsFitUnbounded
+	}
+	return false
+}
+
+type K8SPod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace      string            `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name           string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ready          *K8SReadyState    `protobuf:"bytes,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	Status         K8SPodStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=types.K8SPodStatus" json:"status,omitempty"`
+	Restarts       *K8SRestartsState `protobuf:"bytes,5,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	Age            *K8SAge           `protobuf:"bytes,11,opt,name=age,proto3" json:"age,omitempty"`
+	Ip             string            `protobuf:"bytes,7,opt,name=ip,proto3" json:"ip,omitempty"`
+	Node           string            `protobuf:"bytes,8,opt,name=node,proto3" json:"node,omitempty"`
+	NominatedNode  string            `protobuf:"bytes,9,opt,name=nominated_node,json=nominatedNode,proto3" json:"nominated_node,omitempty"`
+	ReadinessGates string            `protobuf:"bytes,10,opt,name=readiness_gates,json=readinessGates,proto3" json:"readiness_gates,omitempty"`
+}
+
+func (x *K8SPod) Reset() {
+	*x = K8SPod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SPod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SPod) ProtoMessage() {}
+
+func (x *K8SPod) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SPod.ProtoReflect.Descriptor instead.
+func (*K8SPod) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *K8SPod) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *K8SPod) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *K8SPod) GetReady() *K8SReadyState {
+	if x != nil {
+		return x.Ready
+	}
+	return nil
+}
+
+func (x *K8SPod) GetStatus() K8SPodStatus {
+	if x != nil {
+		return x.Status
+	}
+	return K8SPodStatus_Invalid_Pod_Status
+}
+
+func (x *K8SPod) GetRestarts() *K8SRestartsState {
+	if x != nil {
+		return x.Restarts
+	}
+	return nil
+}
+
+func (x *K8SPod) GetAge() *K8SAge {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *K8SPod) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *K8SPod) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *K8SPod) GetNominatedNode() string {
+	if x != nil {
+		return x.NominatedNode
+	}
+	return ""
+}
+
+func (x *K8SPod) GetReadinessGates() string {
+	if x != nil {
+		return x.ReadinessGates
+	}
+	return ""
+}
+
+type K8SNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status           K8SNodeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=types.K8SNodeStatus" json:"status,omitempty"`
+	Roles            string        `protobuf:"bytes,3,opt,name=roles,proto3" json:"roles,omitempty"`
+	Age              *K8SAge       `protobuf:"bytes,11,opt,name=age,proto3" json:"age,omitempty"`
+	Version          string        `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	InternalIp       string        `protobuf:"bytes,6,opt,name=internal_ip,json=internalIp,proto3" json:"internal_ip,omitempty"`
+	ExternalIp       string        `protobuf:"bytes,7,opt,name=external_ip,json=externalIp,proto3" json:"external_ip,omitempty"`
+	OsImage          string        `protobuf:"bytes,8,opt,name=os_image,json=osImage,proto3" json:"os_image,omitempty"`
+	KernelVersion    string        `protobuf:"bytes,9,opt,name=kernel_version,json=kernelVersion,proto3" json:"kernel_version,omitempty"`
+	ContainerRuntime string        `protobuf:"bytes,10,opt,name=container_runtime,json=containerRuntime,proto3" json:"container_runtime,omitempty"`
+}
+
+func (x *K8SNode) Reset() {
+	*x = K8SNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SNode) ProtoMessage() {}
+
+func (x *K8SNode) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SNode.ProtoReflect.Descriptor instead.
+func (*K8SNode) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *K8SNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *K8SNode) GetStatus() K8SNodeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return K8SNodeStatus_Invalid_Node_Status
+}
+
+func (x *K8SNode) GetRoles() string {
+	if x != nil {
+		return x.Roles
+	}
+	return ""
+}
+
+func (x *K8SNode) GetAge() *K8SAge {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *K8SNode) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *K8SNode) GetInternalIp() string {
+	if x != nil {
+		return x.InternalIp
+	}
+	return ""
+}
+
+func (x *K8SNode) GetExternalIp() string {
+	if x != nil {
+		return x.ExternalIp
+	}
+	return ""
+}
+
+func (x *K8SNode) GetOsImage() string {
+	if x != nil {
+		return x.OsImage
+	}
+	return ""
+}
+
+func (x *K8SNode) GetKernelVersion() string {
+	if x != nil {
+		return x.KernelVersion
+	}
+	return ""
+}
+
+func (x *K8SNode) GetContainerRuntime() string {
+	if x != nil {
+		return x.ContainerRuntime
+	}
+	return ""
+}
+
+type K8SDeployment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace  string         `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name       string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ready      *K8SReadyState `protobuf:"bytes,10,opt,name=ready,proto3" json:"ready,omitempty"`
+	UpToDate   *K8SCount      `protobuf:"bytes,11,opt,name=up_to_date,json=upToDate,proto3" json:"up_to_date,omitempty"`
+	Available  *K8SCount      `protobuf:"bytes,12,opt,name=available,proto3" json:"available,omitempty"`
+	Age        *K8SAge        `protobuf:"bytes,13,opt,name=age,proto3" json:"age,omitempty"`
+	Containers string         `protobuf:"bytes,7,opt,name=containers,proto3" json:"containers,omitempty"`
+	Images     string         `protobuf:"bytes,8,opt,name=images,proto3" json:"images,omitempty"`
+	Selector   string         `protobuf:"bytes,9,opt,name=selector,proto3" json:"selector,omitempty"`
+}
+
+func (x *K8SDeployment) Reset() {
+	*x = K8SDeployment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SDeployment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SDeployment) ProtoMessage() {}
+
+func (x *K8SDeployment) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SDeployment.ProtoReflect.Descriptor instead.
+func (*K8SDeployment) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *K8SDeployment) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *K8SDeployment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *K8SDeployment) GetReady() *K8SReadyState {
+	if x != nil {
+		return x.Ready
+	}
+	return nil
+}
+
+func (x *K8SDeployment) GetUpToDate() *K8SCount {
+	if x != nil {
+		return x.UpToDate
+	}
+	return nil
+}
+
+func (x *K8SDeployment) GetAvailable() *K8SCount {
+	if x != nil {
+		return x.Available
+	}
+	return nil
+}
+
+func (x *K8SDeployment) GetAge() *K8SAge {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *K8SDeployment) GetContainers() string {
+	if x != nil {
+		return x.Containers
+	}
+	return ""
+}
+
+func (x *K8SDeployment) GetImages() string {
+	if x != nil {
+		return x.Images
+	}
+	return ""
+}
+
+func (x *K8SDeployment) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+type K8SStatefulSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace  string         `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name       string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ready      *K8SReadyState `protobuf:"bytes,7,opt,name=ready,proto3" json:"ready,omitempty"`
+	Age        *K8SAge        `protobuf:"bytes,8,opt,name=age,proto3" json:"age,omitempty"`
+	Containers string         `protobuf:"bytes,5,opt,name=containers,proto3" json:"containers,omitempty"`
+	Images     string         `protobuf:"bytes,6,opt,name=images,proto3" json:"images,omitempty"`
+}
+
+func (x *K8SStatefulSet) Reset() {
+	*x = K8SStatefulSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SStatefulSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SStatefulSet) ProtoMessage() {}
+
+func (x *K8SStatefulSet) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SStatefulSet.ProtoReflect.Descriptor instead.
+func (*K8SStatefulSet) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *K8SStatefulSet) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *K8SStatefulSet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *K8SStatefulSet) GetReady() *K8SReadyState {
+	if x != nil {
+		return x.Ready
+	}
+	return nil
+}
+
+func (x *K8SStatefulSet) GetAge() *K8SAge {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *K8SStatefulSet) GetContainers() string {
+	if x != nil {
+		return x.Containers
+	}
+	return ""
+}
+
+func (x *K8SStatefulSet) GetImages() string {
+	if x != nil {
+		return x.Images
+	}
+	return ""
+}
+
+type K8SDaemonSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace    string    `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name         string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Desired      *K8SCount `protobuf:"bytes,13,opt,name=desired,proto3" json:"desired,omitempty"`
+	Current      *K8SCount `protobuf:"bytes,14,opt,name=current,proto3" json:"current,omitempty"`
+	Ready        *K8SCount `protobuf:"bytes,15,opt,name=ready,proto3" json:"ready,omitempty"`
+	UpToDate     *K8SCount `protobuf:"bytes,16,opt,name=up_to_date,json=upToDate,proto3" json:"up_to_date,omitempty"`
+	Available    *K8SCount `protobuf:"bytes,17,opt,name=available,proto3" json:"available,omitempty"`
+	NodeSelector string    `protobuf:"bytes,8,opt,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty"`
+	Age          *K8SAge   `protobuf:"bytes,18,opt,name=age,proto3" json:"age,omitempty"`
+	Containers   string    `protobuf:"bytes,10,opt,name=containers,proto3" json:"containers,omitempty"`
+	Images       string    `protobuf:"bytes,11,opt,name=images,proto3" json:"images,omitempty"`
+	Selector     string    `protobuf:"bytes,12,opt,name=selector,proto3" json:"selector,omitempty"`
+}
+
+func (x *K8SDaemonSet) Reset() {
+	*x = K8SDaemonSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SDaemonSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SDaemonSet) ProtoMessage() {}
+
+func (x *K8SDaemonSet) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SDaemonSet.ProtoReflect.Descriptor instead.
+func (*K8SDaemonSet) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *K8SDaemonSet) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *K8SDaemonSet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *K8SDaemonSet) GetDesired() *K8SCount {
+	if x != nil {
+		return x.Desired
+	}
+	return nil
+}
+
+func (x *K8SDaemonSet) GetCurrent() *K8SCount {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *K8SDaemonSet) GetReady() *K8SCount {
+	if x != nil {
+		return x.Ready
+	}
+	return nil
+}
+
+func (x *K8SDaemonSet) GetUpToDate() *K8SCount {
+	if x != nil {
+		return x.UpToDate
+	}
+	return nil
+}
+
+func (x *K8SDaemonSet) GetAvailable() *K8SCount {
+	if x != nil {
+		return x.Available
+	}
+	return nil
+}
+
+func (x *K8SDaemonSet) GetNodeSelector() string {
+	if x != nil {
+		return x.NodeSelector
+	}
+	return ""
+}
+
+func (x *K8SDaemonSet) GetAge() *K8SAge {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *K8SDaemonSet) GetContainers() string {
+	if x != nil {
+		return x.Containers
+	}
+	return ""
+}
+
+func (x *K8SDaemonSet) GetImages() string {
+	if x != nil {
+		return x.Images
+	}
+	return ""
+}
+
+func (x *K8SDaemonSet) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+type K8SService struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace  string  `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name       string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type       string  `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ClusterIp  string  `protobuf:"bytes,4,opt,name=cluster_ip,json=clusterIp,proto3" json:"cluster_ip,omitempty"`
+	ExternalIp string  `protobuf:"bytes,5,opt,name=external_ip,json=externalIp,proto3" json:"external_ip,omitempty"`
+	Ports      string  `protobuf:"bytes,6,opt,name=ports,proto3" json:"ports,omitempty"`
+	Age        *K8SAge `protobuf:"bytes,9,opt,name=age,proto3" json:"age,omitempty"`
+	Selector   string  `protobuf:"bytes,8,opt,name=selector,proto3" json:"selector,omitempty"`
+}
+
+func (x *K8SService) Reset() {
+	*x = K8SService{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SService) ProtoMessage() {}
+
+func (x *K8SService) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SService.ProtoReflect.Descriptor instead.
+func (*K8SService) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *K8SService) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *K8SService) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *K8SService) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *K8SService) GetClusterIp() string {
+	if x != nil {
+		return x.ClusterIp
+	}
+	return ""
+}
+
+func (x *K8SService) GetExternalIp() string {
+	if x != nil {
+		return x.ExternalIp
+	}
+	return ""
+}
+
+func (x *K8SService) GetPorts() string {
+	if x != nil {
+		return x.Ports
+	}
+	return ""
+}
+
+func (x *K8SService) GetAge() *K8SAge {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *K8SService) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+type K8SNamespace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status string  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Age    *K8SAge `protobuf:"bytes,4,opt,name=age,proto3" json:"age,omitempty"`
+}
+
+func (x *K8SNamespace) Reset() {
+	*x = K8SNamespace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SNamespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SNamespace) ProtoMessage() {}
+
+func (x *K8SNamespace) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SNamespace.ProtoReflect.Descriptor instead.
+func (*K8SNamespace) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *K8SNamespace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *K8SNamespace) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *K8SNamespace) GetAge() *K8SAge {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+type K8SNetworkPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace   string  `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name        string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PodSelector string  `protobuf:"bytes,3,opt,name=pod_selector,json=podSelector,proto3" json:"pod_selector,omitempty"`
+	Age         *K8SAge `protobuf:"bytes,5,opt,name=age,proto3" json:"age,omitempty"`
+}
+
+func (x *K8SNetworkPolicy) Reset() {
+	*x = K8SNetworkPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_k8s_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *K8SNetworkPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SNetworkPolicy) ProtoMessage() {}
+
+func (x *K8SNetworkPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_k8s_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SNetworkPolicy.ProtoReflect.Descriptor instead.
+func (*K8SNetworkPolicy) Descriptor() ([]byte, []int) {
+	return file_k8s_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *K8SNetworkPolicy) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *K8SNetworkPolicy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *K8SNetworkPolicy) GetPodSelector() string {
+	if x != nil {
+		return x.PodSelector
+	}
+	return ""
+}
+
+func (x *K8SNetworkPolicy) GetAge() *K8SAge {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+var File_k8s_proto protoreflect.FileDescriptor
+
+var file_k8s_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x6b, 0x38, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x1a, 0x10, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3b, 0x0a, 0x0d, 0x4b, 0x38, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x75, 0x74, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x75, 0x74, 0x6f,
+	0x66, 0x22, 0x3a, 0x0a, 0x10, 0x4b, 0x38, 0x73, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61,
+	0x67, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x67, 0x6f, 0x22, 0x20, 0x0a,
+	0x08, 0x4b, 0x38, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x34, 0x0a, 0x06, 0x4b, 0x38, 0x73, 0x41, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x37, 0x0a, 0x0e, 0x4b, 0x38, 0x73, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38,
+	0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xeb,
+	0x09, 0x0a, 0x0a, 0x4b, 0x38, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x32, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x70, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x04, 0x70, 0x6f, 0x64, 0x73, 0x12, 0x44, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0c,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x73, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x73, 0x65,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75,
+	0x6c, 0x73, 0x65, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x73,
+	0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x07,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x48, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b,
+	0x38, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x46, 0x0a, 0x09, 0x50, 0x6f, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x23, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x50, 0x6f, 0x64, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x54, 0x0a, 0x10, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x56, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x73, 0x65, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x52, 0x0a, 0x0f, 0x44, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x73, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4e, 0x0a, 0x0d, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x52, 0x0a, 0x0f, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x5b, 0x0a, 0x14, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4b, 0x38, 0x73, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbc, 0x1a, 0x0a,
+	0x0a, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x70,
+	0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x64,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x70, 0x6f, 0x64, 0x73, 0x12, 0x44, 0x0a, 0x0b,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x73, 0x65,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x66, 0x75, 0x6c, 0x73, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x73, 0x65, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2e, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0a, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x73, 0x12, 0x3b,
+	0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x09, 0x69,
+	0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x09, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x6e,
+	0x6f, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x41, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x12, 0x50, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x11, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x74, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x70, 0x65, 0x72, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x74, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x52,
+	0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x12, 0x47, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b,
+	0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x72, 0x6f, 0x6c,
+	0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x5c, 0x0a, 0x13, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x72, 0x6f, 0x6c,
+	0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x44,
+	0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x65, 0x74, 0x73, 0x18, 0x11, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x65,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x65, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x12, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x72, 0x6f, 0x6e, 0x6a, 0x6f, 0x62,
+	0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x6a,
+	0x6f, 0x62, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63, 0x72, 0x6f, 0x6e, 0x6a, 0x6f,
+	0x62, 0x73, 0x12, 0x3e, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38,
+	0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x65, 0x0a, 0x16, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x15, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x16, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x0e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x6d, 0x61, 0x70, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61, 0x70, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x1a, 0x43, 0x0a, 0x09, 0x50, 0x6f, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x20, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x51, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a, 0x11, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x73, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75,
+	0x6c, 0x53, 0x65, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x4f, 0x0a, 0x0f, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x4b, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4c, 0x0a,
+	0x0e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a, 0x0a, 0x4e,
+	0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x4f, 0x0a, 0x0f, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x14, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5d, 0x0a,
+	0x16, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a, 0x0a,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a, 0x11, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a, 0x11, 0x52, 0x6f, 0x6c, 0x65,
+	0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x61, 0x0a,
+	0x18, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x51, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x65, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x43, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x20, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4b, 0x0a, 0x0d, 0x43, 0x72, 0x6f, 0x6e,
+	0x6a, 0x6f, 0x62, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4e, 0x0a, 0x0e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x67, 0x0a, 0x1b, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x74, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x65,
+	0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x56,
+	0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4f, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x6d, 0x61, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x4a, 0x04, 0x08, 0x0f, 0x10, 0x10, 0x52, 0x13, 0x70, 0x6f, 0x64, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x11,
+	0x4b, 0x38, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3b, 0x0a, 0x10, 0x4b, 0x38, 0x73, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x22, 0x86, 0x03, 0x0a, 0x0c, 0x4b, 0x38, 0x73, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x70, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x6f, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x69,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x12, 0x37, 0x0a,
+	0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x3b, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb7, 0x01,
+	0x0a, 0x0d, 0x4b, 0x38, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x33, 0x0a, 0x0c, 0x4b, 0x38, 0x73, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38,
+	0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x9e, 0x03, 0x0a,
+	0x08, 0x4b, 0x38, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x70, 0x6f, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x6f, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b,
+	0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x53, 0x0a,
+	0x0e, 0x4b, 0x38, 0x73, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x22, 0x43, 0x0a, 0x14, 0x4b, 0x38, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4b, 0x38, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xe2, 0x01, 0x0a, 0x10, 0x4b, 0x38, 0x73, 0x50,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x72, 0x69, 0x74,
+	0x69, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x69, 0x6e, 0x66, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x6c,
+	0x65, 0x6d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x22, 0xfa, 0x01, 0x0a,
+	0x0a, 0x4b, 0x38, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x35, 0x0a, 0x08, 0x73,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x29, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x50, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x65, 0x78, 0x74, 0x53, 0x74, 0x65, 0x70, 0x73, 0x22, 0x49, 0x0a, 0x0f, 0x4b, 0x38, 0x73,
+	0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x22, 0x43, 0x0a, 0x14, 0x4b, 0x38, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xb7, 0x03, 0x0a, 0x10, 0x4b, 0x38,
+	0x73, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x63, 0x61, 0x6e,
+	0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x63, 0x61, 0x6e, 0x6e,
+	0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x72, 0x69, 0x74,
+	0x69, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x69, 0x6e, 0x66, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x57, 0x0a,
+	0x10, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4b, 0x38, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4b, 0x38, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x46, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x31, 0x0a, 0x05,
+	0x74, 0x72, 0x65, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x54, 0x72,
+	0x65, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x1a,
+	0x42, 0x0a, 0x14, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xfe, 0x01, 0x0a, 0x11, 0x4b, 0x38, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x75,
+	0x72, 0x65, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4b, 0x38, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b,
+	0x38, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x22, 0xa2, 0x02, 0x0a, 0x14, 0x4b, 0x38, 0x73, 0x50, 0x6f, 0x73, 0x74,
+	0x75, 0x72, 0x65, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x72, 0x69, 0x74, 0x69,
+	0x63, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x69, 0x6e, 0x66, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x0b,
+	0x72, 0x75, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x50, 0x6f, 0x73,
+	0x74, 0x75, 0x72, 0x65, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52,
+	0x75, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
+	0x72, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x52, 0x75,
+	0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa7, 0x01, 0x0a, 0x0d, 0x4b, 0x38,
+	0x73, 0x44, 0x72, 0x69, 0x66, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
+	0x65, 0x66, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6c, 0x65, 0x66, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x25,
+	0x0a, 0x0e, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x65, 0x66, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x69,
+	0x67, 0x68, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x69,
+	0x67, 0x68, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x69, 0x67, 0x68, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x08, 0x4b, 0x38, 0x73, 0x44, 0x72, 0x69, 0x66, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6c, 0x65, 0x66, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x44, 0x72, 0x69, 0x66, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x0c, 0x4b, 0x38, 0x73, 0x44, 0x72,
+	0x69, 0x66, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x27, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38,
+	0x73, 0x44, 0x72, 0x69, 0x66, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x69, 0x6e,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0x64, 0x0a, 0x10, 0x4b, 0x38, 0x73, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x6f, 0x64, 0x5f, 0x63, 0x70, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x6f, 0x64, 0x43, 0x70, 0x75, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x64, 0x5f, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6f, 0x64, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x22, 0x45, 0x0a, 0x15, 0x4b, 0x38, 0x73, 0x43, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xb9, 0x01, 0x0a,
+	0x11, 0x4b, 0x38, 0x73, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x6f, 0x64, 0x5f, 0x63, 0x70, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x6f, 0x64, 0x43, 0x70, 0x75, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x64, 0x5f, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6f, 0x64, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28,
+	0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0xee, 0x04, 0x0a, 0x0b, 0x4b, 0x38, 0x73,
+	0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x70,
+	0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x43, 0x70, 0x75, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x70, 0x75, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x43, 0x70, 0x75, 0x12, 0x1b, 0x0a, 0x09,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x63, 0x70, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x70, 0x75, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x6f, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x6f, 0x64,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a,
+	0x0a, 0x11, 0x63, 0x70, 0x75, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x63, 0x70, 0x75, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x70, 0x75, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x63, 0x70, 0x75, 0x4f, 0x76, 0x65, 0x72, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6f, 0x76,
+	0x65, 0x72, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x73, 0x5f, 0x66, 0x69, 0x74, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x73, 0x46, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x70,
+	0x6f, 0x64, 0x73, 0x5f, 0x66, 0x69, 0x74, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x65,
+	0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x6f, 0x64, 0x73, 0x46, 0x69, 0x74,
+	0x55, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x22, 0xe3, 0x02, 0x0a, 0x06, 0x4b, 0x38,
+	0x73, 0x50, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x50, 0x6f,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x33, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x52, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x41, 0x67, 0x65,
+	0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x6f, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22,
+	0xd3, 0x02, 0x0a, 0x07, 0x4b, 0x38, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x41, 0x67, 0x65, 0x52,
+	0x03, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x70, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x70, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x70, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x70,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x73, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x73, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6b,
+	0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4a,
+	0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0xc6, 0x02, 0x0a, 0x0d, 0x4b, 0x38, 0x73, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4b, 0x38, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x2d, 0x0a, 0x0a, 0x75, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x75, 0x70, 0x54, 0x6f,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4b, 0x38, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x41, 0x67, 0x65, 0x52,
+	0x03, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x07, 0x22, 0xd3,
+	0x01, 0x0a, 0x0e, 0x4b, 0x38, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x52, 0x65,
+	0x61, 0x64, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12,
+	0x1f, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x41, 0x67, 0x65, 0x52, 0x03, 0x61, 0x67, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04,
+	0x08, 0x04, 0x10, 0x05, 0x22, 0xc1, 0x03, 0x0a, 0x0c, 0x4b, 0x38, 0x73, 0x44, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x64, 0x65, 0x73, 0x69, 0x72,
+	0x65, 0x64, 0x12, 0x29, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x12, 0x2d, 0x0a, 0x0a, 0x75, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4b, 0x38, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x75, 0x70, 0x54, 0x6f, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b,
+	0x38, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73,
+	0x41, 0x67, 0x65, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4a, 0x04, 0x08, 0x03,
+	0x10, 0x08, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x22, 0xeb, 0x01, 0x0a, 0x0a, 0x4b, 0x38, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1f, 0x0a, 0x0b,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x41, 0x67, 0x65, 0x52,
+	0x03, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x22, 0x61, 0x0a, 0x0c, 0x4b, 0x38, 0x73, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1f, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x41, 0x67, 0x65, 0x52, 0x03,
+	0x61, 0x67, 0x65, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x8e, 0x01, 0x0a, 0x10, 0x4b, 0x38,
+	0x73, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x38, 0x73, 0x41, 0x67, 0x65, 0x52,
+	0x03, 0x61, 0x67, 0x65, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x2a, 0xd6, 0x01, 0x0a, 0x0c, 0x4b,
+	0x38, 0x73, 0x50, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x49,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x50, 0x6f, 0x64, 0x5f, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x0d, 0x0a,
+	0x09, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x72, 0x61, 0x73, 0x68, 0x4c, 0x6f,
+	0x6f, 0x70, 0x42, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x54,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c,
+	0x42, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x10, 0x09, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x10, 0x0a, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x10, 0x0b, 0x2a, 0x33, 0x0a, 0x0d, 0x4b, 0x38, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f,
+	0x4e, 0x6f, 0x64, 0x65, 0x5f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x00, 0x12, 0x09, 0x0a,
+	0x05, 0x52, 0x65, 0x61, 0x64, 0x79, 0x10, 0x01, 0x2a, 0x6c, 0x0a, 0x12, 0x4b, 0x38, 0x73, 0x50,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1c,
+	0x0a, 0x18, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65,
+	0x6d, 0x5f, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x10, 0x01, 0x12, 0x12, 0x0a,
+	0x0e, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x10,
+	0x02, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x43, 0x72, 0x69, 0x74,
+	0x69, 0x63, 0x61, 0x6c, 0x10, 0x03, 0x2a, 0xbd, 0x01, 0x0a, 0x0e, 0x4b, 0x38, 0x73, 0x50, 0x72,
+	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x4b, 0x69, 0x6e,
+	0x64, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x6f, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x69, 0x6e,
+	0x67, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x6f, 0x64, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x55, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x03, 0x12, 0x1a,
+	0x0a, 0x16, 0x53, 0x74, 0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x74, 0x55, 0x6e, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x10, 0x05,
+	0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79,
+	0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x52, 0x69,
+	0x73, 0x69, 0x6e, 0x67, 0x10, 0x07, 0x2a, 0xbf, 0x01, 0x0a, 0x0e, 0x4b, 0x38, 0x73, 0x50, 0x6f,
+	0x73, 0x74, 0x75, 0x72, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x52, 0x75, 0x6c,
+	0x65, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65,
+	0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x52, 0x75, 0x6e, 0x41, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x48,
+	0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x10, 0x03, 0x12, 0x12,
+	0x0a, 0x0e, 0x48, 0x6f, 0x73, 0x74, 0x50, 0x61, 0x74, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x57, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x52, 0x6f,
+	0x6c, 0x65, 0x10, 0x05, 0x12, 0x21, 0x0a, 0x1d, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x49, 0x6e, 0x45, 0x6e, 0x76, 0x10, 0x07, 0x2a, 0x93, 0x01, 0x0a, 0x0c, 0x4b, 0x38, 0x73,
+	0x44, 0x72, 0x69, 0x66, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x44, 0x72, 0x69, 0x66, 0x74, 0x5f, 0x4b, 0x69, 0x6e, 0x64, 0x10,
+	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x6e, 0x6c, 0x79, 0x49, 0x6e, 0x4c, 0x65, 0x66, 0x74, 0x10,
+	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x6e, 0x6c, 0x79, 0x49, 0x6e, 0x52, 0x69, 0x67, 0x68, 0x74,
+	0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x72, 0x69, 0x66, 0x74,
+	0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x44, 0x72, 0x69,
+	0x66, 0x74, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44,
+	0x72, 0x69, 0x66, 0x74, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x72, 0x69, 0x66, 0x74, 0x10, 0x06, 0x42, 0x21,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x38, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42,
+	0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x01, 0x5a, 0x07, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_k8s_proto_rawDescOnce sync.Once
+	file_k8s_proto_rawDescData = file_k8s_proto_rawDesc
+)
+
+func file_k8s_proto_rawDescGZIP() []byte {
+	file_k8s_proto_rawDescOnce.Do(func() {
+		file_k8s_proto_rawDescData = protoimpl.X.CompressGZIP(file_k8s_proto_rawDescData)
+	})
+	return file_k8s_proto_rawDescData
+}
+
+var file_k8s_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_k8s_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
+var file_k8s_proto_goTypes = []interface{}{
+	(K8SPodStatus)(0),             // 0: types.K8sPodStatus
+	(K8SNodeStatus)(0),            // 1: types.K8sNodeStatus
+	(K8SProblemSeverity)(0),       // 2: types.K8sProblemSeverity
+	(K8SProblemKind)(0),           // 3: types.K8sProblemKind
+	(K8SPostureRule)(0),           // 4: types.K8sPostureRule
+	(K8SDriftKind)(0),             // 5: types.K8sDriftKind
+	(*K8SReadyState)(nil),         // 6: types.K8sReadyState
+	(*K8SRestartsState)(nil),      // 7: types.K8sRestartsState
+	(*K8SCount)(nil),              // 8: types.K8sCount
+	(*K8SAge)(nil),                // 9: types.K8sAge
+	(*K8SClusterList)(nil),        // 10: types.K8sClusterList
+	(*K8SCluster)(nil),            // 11: types.K8sCluster
+	(*K8SObjects)(nil),            // 12: types.K8sObjects
+	(*K8SContainerQuery)(nil),     // 13: types.K8sContainerQuery
+	(*K8SContainerList)(nil),      // 14: types.K8sContainerList
+	(*K8SContainer)(nil),          // 15: types.K8sContainer
+	(*K8SEventQuery)(nil),         // 16: types.K8sEventQuery
+	(*K8SEventList)(nil),          // 17: types.K8sEventList
+	(*K8SEvent)(nil),              // 18: types.K8sEvent
+	(*K8SDoctorQuery)(nil),        // 19: types.K8sDoctorQuery
+	(*K8SProblemReportList)(nil),  // 20: types.K8sProblemReportList
+	(*K8SProblemReport)(nil),      // 21: types.K8sProblemReport
+	(*K8SProblem)(nil),            // 22: types.K8sProblem
+	(*K8SPostureQuery)(nil),       // 23: types.K8sPostureQuery
+	(*K8SPostureReportList)(nil),  // 24: types.K8sPostureReportList
+	(*K8SPostureReport)(nil),      // 25: types.K8sPostureReport
+	(*K8SPostureFinding)(nil),     // 26: types.K8sPostureFinding
+	(*K8SPostureTrendPoint)(nil),  // 27: types.K8sPostureTrendPoint
+	(*K8SDriftQuery)(nil),         // 28: types.K8sDriftQuery
+	(*K8SDrift)(nil),              // 29: types.K8sDrift
+	(*K8SDriftItem)(nil),          // 30: types.K8sDriftItem
+	(*K8SCapacityQuery)(nil),      // 31: types.K8sCapacityQuery
+	(*K8SCapacityReportList)(nil), // 32: types.K8sCapacityReportList
+	(*K8SCapacityReport)(nil),     // 33: types.K8sCapacityReport
+	(*K8SCapacity)(nil),           // 34: types.K8sCapacity
+	(*K8SPod)(nil),                // 35: types.K8sPod
+	(*K8SNode)(nil),               // 36: types.K8sNode
+	(*K8SDeployment)(nil),         // 37: types.K8sDeployment
+	(*K8SStatefulSet)(nil),        // 38: types.K8sStatefulSet
+	(*K8SDaemonSet)(nil),          // 39: types.K8sDaemonSet
+	(*K8SService)(nil),            // 40: types.K8sService
+	(*K8SNamespace)(nil),          // 41: types.K8sNamespace
+	(*K8SNetworkPolicy)(nil),      // 42: types.K8sNetworkPolicy
+	nil,                           // 43: types.K8sCluster.NodesEntry
+	nil,                           // 44: types.K8sCluster.PodsEntry
+	nil,                           // 45: types.K8sCluster.DeploymentsEntry
+	nil,                           // 46: types.K8sCluster.StatefulsetsEntry
+	nil,                           // 47: types.K8sCluster.DaemonsetsEntry
+	nil,                           // 48: types.K8sCluster.ServicesEntry
+	nil,                           // 49: types.K8sCluster.NamespacesEntry
+	nil,                           // 50: types.K8sCluster.NetworkpoliciesEntry
+	nil,                           // 51: types.K8sObjects.PodsEntry
+	nil,                           // 52: types.K8sObjects.DeploymentsEntry
+	nil,                           // 53: types.K8sObjects.StatefulsetsEntry
+	nil,                           // 54: types.K8sObjects.DaemonsetsEntry
+	nil,                           // 55: types.K8sObjects.ServicesEntry
+	nil,                           // 56: types.K8sObjects.IngressesEntry
+	nil,                           // 57: types.K8sObjects.NodesEntry
+	nil,                           // 58: types.K8sObjects.NamespacesEntry
+	nil,                           // 59: types.K8sObjects.NetworkpoliciesEntry
+	nil,                           // 60: types.K8sObjects.PersistentvolumesEntry
+	nil,                           // 61: types.K8sObjects.RolesEntry
+	nil,                           // 62: types.K8sObjects.ClusterrolesEntry
+	nil,                           // 63: types.K8sObjects.RolebindingsEntry
+	nil,                           // 64: types.K8sObjects.ClusterrolebindingsEntry
+	nil,                           // 65: types.K8sObjects.ReplicasetsEntry
+	nil,                           // 66: types.K8sObjects.JobsEntry
+	nil,                           // 67: types.K8sObjects.CronjobsEntry
+	nil,                           // 68: types.K8sObjects.EndpointsEntry
+	nil,                           // 69: types.K8sObjects.PersistentvolumeclaimsEntry
+	nil,                           // 70: types.K8sObjects.StorageclassesEntry
+	nil,                           // 71: types.K8sObjects.ConfigmapsEntry
+	nil,                           // 72: types.K8sObjects.EventsEntry
+	nil,                           // 73: types.K8sContainer.LimitsEntry
+	nil,                           // 74: types.K8sContainer.RequestsEntry
+	nil,                           // 75: types.K8sPostureReport.NamespaceCountsEntry
+	nil,                           // 76: types.K8sPostureTrendPoint.RuleCountsEntry
+	(*Pod)(nil),                   // 77: types.Pod
+	(*Deployment)(nil),            // 78: types.Deployment
+	(*StatefulSet)(nil),           // 79: types.StatefulSet
+	(*DaemonSet)(nil),             // 80: types.DaemonSet
+	(*Service)(nil),               // 81: types.Service
+	(*Ingress)(nil),               // 82: types.Ingress
+	(*Node)(nil),                  // 83: types.Node
+	(*Namespace)(nil),             // 84: types.Namespace
+	(*NetworkPolicy)(nil),         // 85: types.NetworkPolicy
+	(*PersistentVolume)(nil),      // 86: types.PersistentVolume
+	(*Role)(nil),                  // 87: types.Role
+	(*ClusterRole)(nil),           // 88: types.ClusterRole
+	(*RoleBinding)(nil),           // 89: types.RoleBinding
+	(*ClusterRoleBinding)(nil),    // 90: types.ClusterRoleBinding
+	(*ReplicaSet)(nil),            // 91: types.ReplicaSet
+	(*Job)(nil),                   // 92: types.Job
+	(*CronJob)(nil),               // 93: types.CronJob
+	(*Endpoints)(nil),             // 94: types.Endpoints
+	(*PersistentVolumeClaim)(nil), // 95: types.PersistentVolumeClaim
+	(*StorageClass)(nil),          // 96: types.StorageClass
+	(*ConfigMap)(nil),             // 97: types.ConfigMap
+	(*Event)(nil),                 // 98: types.Event
+}
+var file_k8s_proto_depIdxs = []int32{
+	11,  // 0: types.K8sClusterList.list:type_name -> types.K8sCluster
+	43,  // 1: types.K8sCluster.nodes:type_name -> types.K8sCluster.NodesEntry
+	44,  // 2: types.K8sCluster.pods:type_name -> types.K8sCluster.PodsEntry
+	45,  // 3: types.K8sCluster.deployments:type_name -> types.K8sCluster.DeploymentsEntry
+	46,  // 4: types.K8sCluster.statefulsets:type_name -> types.K8sCluster.StatefulsetsEntry
+	47,  // 5: types.K8sCluster.daemonsets:type_name -> types.K8sCluster.DaemonsetsEntry
+	48,  // 6: types.K8sCluster.services:type_name -> types.K8sCluster.ServicesEntry
+	49,  // 7: types.K8sCluster.namespaces:type_name -> types.K8sCluster.NamespacesEntry
+	50,  // 8: types.K8sCluster.networkpolicies:type_name -> types.K8sCluster.NetworkpoliciesEntry
+	12,  // 9: types.K8sCluster.objects:type_name -> types.K8sObjects
+	51,  // 10: types.K8sObjects.pods:type_name -> types.K8sObjects.PodsEntry
+	52,  // 11: types.K8sObjects.deployments:type_name -> types.K8sObjects.DeploymentsEntry
+	53,  // 12: types.K8sObjects.statefulsets:type_name -> types.K8sObjects.StatefulsetsEntry
+	54,  // 13: types.K8sObjects.daemonsets:type_name -> types.K8sObjects.DaemonsetsEntry
+	55,  // 14: types.K8sObjects.services:type_name -> types.K8sObjects.ServicesEntry
+	56,  // 15: types.K8sObjects.ingresses:type_name -> types.K8sObjects.IngressesEntry
+	57,  // 16: types.K8sObjects.nodes:type_name -> types.K8sObjects.NodesEntry
+	58,  // 17: types.K8sObjects.namespaces:type_name -> types.K8sObjects.NamespacesEntry
+	59,  // 18: types.K8sObjects.networkpolicies:type_name -> types.K8sObjects.NetworkpoliciesEntry
+	60,  // 19: types.K8sObjects.persistentvolumes:type_name -> types.K8sObjects.PersistentvolumesEntry
+	61,  // 20: types.K8sObjects.roles:type_name -> types.K8sObjects.RolesEntry
+	62,  // 21: types.K8sObjects.clusterroles:type_name -> types.K8sObjects.ClusterrolesEntry
+	63,  // 22: types.K8sObjects.rolebindings:type_name -> types.K8sObjects.RolebindingsEntry
+	64,  // 23: types.K8sObjects.clusterrolebindings:type_name -> types.K8sObjects.ClusterrolebindingsEntry
+	65,  // 24: types.K8sObjects.replicasets:type_name -> types.K8sObjects.ReplicasetsEntry
+	66,  // 25: types.K8sObjects.jobs:type_name -> types.K8sObjects.JobsEntry
+	67,  // 26: types.K8sObjects.cronjobs:type_name -> types.K8sObjects.CronjobsEntry
+	68,  // 27: types.K8sObjects.endpoints:type_name -> types.K8sObjects.EndpointsEntry
+	69,  // 28: types.K8sObjects.persistentvolumeclaims:type_name -> types.K8sObjects.PersistentvolumeclaimsEntry
+	70,  // 29: types.K8sObjects.storageclasses:type_name -> types.K8sObjects.StorageclassesEntry
+	71,  // 30: types.K8sObjects.configmaps:type_name -> types.K8sObjects.ConfigmapsEntry
+	72,  // 31: types.K8sObjects.events:type_name -> types.K8sObjects.EventsEntry
+	15,  // 32: types.K8sContainerList.list:type_name -> types.K8sContainer
+	73,  // 33: types.K8sContainer.limits:type_name -> types.K8sContainer.LimitsEntry
+	74,  // 34: types.K8sContainer.requests:type_name -> types.K8sContainer.RequestsEntry
+	18,  // 35: types.K8sEventList.list:type_name -> types.K8sEvent
+	21,  // 36: types.K8sProblemReportList.list:type_name -> types.K8sProblemReport
+	22,  // 37: types.K8sProblemReport.problems:type_name -> types.K8sProblem
+	2,   // 38: types.K8sProblem.severity:type_name -> types.K8sProblemSeverity
+	3,   // 39: types.K8sProblem.kind:type_name -> types.K8sProblemKind
+	25,  // 40: types.K8sPostureReportList.list:type_name -> types.K8sPostureReport
+	75,  // 41: types.K8sPostureReport.namespace_counts:type_name -> types.K8sPostureReport.NamespaceCountsEntry
+	26,  // 42: types.K8sPostureReport.findings:type_name -> types.K8sPostureFinding
+	27,  // 43: types.K8sPostureReport.trend:type_name -> types.K8sPostureTrendPoint
+	4,   // 44: types.K8sPostureFinding.rule:type_name -> types.K8sPostureRule
+	2,   // 45: types.K8sPostureFinding.severity:type_name -> types.K8sProblemSeverity
+	76,  // 46: types.K8sPostureTrendPoint.rule_counts:type_name -> types.K8sPostureTrendPoint.RuleCountsEntry
+	30,  // 47: types.K8sDrift.items:type_name -> types.K8sDriftItem
+	5,   // 48: types.K8sDriftItem.kind:type_name -> types.K8sDriftKind
+	33,  // 49: types.K8sCapacityReportList.list:type_name -> types.K8sCapacityReport
+	34,  // 50: types.K8sCapacityReport.total:type_name -> types.K8sCapacity
+	34,  // 51: types.K8sCapacityReport.nodes:type_name -> types.K8sCapacity
+	6,   // 52: types.K8sPod.ready:type_name -> types.K8sReadyState
+	0,   // 53: types.K8sPod.status:type_name -> types.K8sPodStatus
+	7,   // 54: types.K8sPod.restarts:type_name -> types.K8sRestartsState
+	9,   // 55: types.K8sPod.age:type_name -> types.K8sAge
+	1,   // 56: types.K8sNode.status:type_name -> types.K8sNodeStatus
+	9,   // 57: types.K8sNode.age:type_name -> types.K8sAge
+	6,   // 58: types.K8sDeployment.ready:type_name -> types.K8sReadyState
+	8,   // 59: types.K8sDeployment.up_to_date:type_name -> types.K8sCount
+	8,   // 60: types.K8sDeployment.available:type_name -> types.K8sCount
+	9,   // 61: types.K8sDeployment.age:type_name -> types.K8sAge
+	6,   // 62: types.K8sStatefulSet.ready:type_name -> types.K8sReadyState
+	9,   // 63: types.K8sStatefulSet.age:type_name -> types.K8sAge
+	8,   // 64: types.K8sDaemonSet.desired:type_name -> types.K8sCount
+	8,   // 65: types.K8sDaemonSet.current:type_name -> types.K8sCount
+	8,   // 66: types.K8sDaemonSet.ready:type_name -> types.K8sCount
+	8,   // 67: types.K8sDaemonSet.up_to_date:type_name -> types.K8sCount
+	8,   // 68: types.K8sDaemonSet.available:type_name -> types.K8sCount
+	9,   // 69: types.K8sDaemonSet.age:type_name -> types.K8sAge
+	9,   // 70: types.K8sService.age:type_name -> types.K8sAge
+	9,   // 71: types.K8sNamespace.age:type_name -> types.K8sAge
+	9,   // 72: types.K8sNetworkPolicy.age:type_name -> types.K8sAge
+	36,  // 73: types.K8sCluster.NodesEntry.value:type_name -> types.K8sNode
+	35,  // 74: types.K8sCluster.PodsEntry.value:type_name -> types.K8sPod
+	37,  // 75: types.K8sCluster.DeploymentsEntry.value:type_name -> types.K8sDeployment
+	38,  // 76: types.K8sCluster.StatefulsetsEntry.value:type_name -> types.K8sStatefulSet
+	39,  // 77: types.K8sCluster.DaemonsetsEntry.value:type_name -> types.K8sDaemonSet
+	40,  // 78: types.K8sCluster.ServicesEntry.value:type_name -> types.K8sService
+	41,  // 79: types.K8sCluster.NamespacesEntry.value:type_name -> types.K8sNamespace
+	42,  // 80: types.K8sCluster.NetworkpoliciesEntry.value:type_name -> types.K8sNetworkPolicy
+	77,  // 81: types.K8sObjects.PodsEntry.value:type_name -> types.Pod
+	78,  // 82: types.K8sObjects.DeploymentsEntry.value:type_name -> types.Deployment
+	79,  // 83: types.K8sObjects.StatefulsetsEntry.value:type_name -> types.StatefulSet
+	80,  // 84: types.K8sObjects.DaemonsetsEntry.value:type_name -> types.DaemonSet
+	81,  // 85: types.K8sObjects.ServicesEntry.value:type_name -> types.Service
+	82,  // 86: types.K8sObjects.IngressesEntry.value:type_name -> types.Ingress
+	83,  // 87: types.K8sObjects.NodesEntry.value:type_name -> types.Node
+	84,  // 88: types.K8sObjects.NamespacesEntry.value:type_name -> types.Namespace
+	85,  // 89: types.K8sObjects.NetworkpoliciesEntry.value:type_name -> types.NetworkPolicy
+	86,  // 90: types.K8sObjects.PersistentvolumesEntry.value:type_name -> types.PersistentVolume
+	87,  // 91: types.K8sObjects.RolesEntry.value:type_name -> types.Role
+	88,  // 92: types.K8sObjects.ClusterrolesEntry.value:type_name -> types.ClusterRole
+	89,  // 93: types.K8sObjects.RolebindingsEntry.value:type_name -> types.RoleBinding
+	90,  // 94: types.K8sObjects.ClusterrolebindingsEntry.value:type_name -> types.ClusterRoleBinding
+	91,  // 95: types.K8sObjects.ReplicasetsEntry.value:type_name -> types.ReplicaSet
+	92,  // 96: types.K8sObjects.JobsEntry.value:type_name -> types.Job
+	93,  // 97: types.K8sObjects.CronjobsEntry.value:type_name -> types.CronJob
+	94,  // 98: types.K8sObjects.EndpointsEntry.value:type_name -> types.Endpoints
+	95,  // 99: types.K8sObjects.PersistentvolumeclaimsEntry.value:type_name -> types.PersistentVolumeClaim
+	96,  // 100: types.K8sObjects.StorageclassesEntry.value:type_name -> types.StorageClass
+	97,  // 101: types.K8sObjects.ConfigmapsEntry.value:type_name -> types.ConfigMap
+	98,  // 102: types.K8sObjects.EventsEntry.value:type_name -> types.Event
+	103, // [103:103] is the sub-list for method output_type
+	103, // [103:103] is the sub-list for method input_type
+	103, // [103:103] is the sub-list for extension type_name
+	103, // [103:103] is the sub-list for extension extendee
+	0,   // [0:103] is the sub-list for field type_name
+}
+
+func init() { file_k8s_proto_init() }
+func file_k8s_proto_init() {
+	if File_k8s_proto != nil {
+		return
+	}
+	file_kubernetes_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_k8s_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SReadyState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SRestartsState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SAge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SClusterList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*K8SCluster); i {
 			case 0:
 				return &v.state
@@ -1567,7 +4659,283 @@ func file_k8s_proto_init() {
 				return nil
 			}
 		}
-		file_k8s_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_k8s_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SObjects); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SContainerQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SContainerList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SContainer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SEventQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SEventList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SDoctorQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SProblemReportList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SProblemReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SProblem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SPostureQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SPostureReportList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SPostureReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SPostureFinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SPostureTrendPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SDriftQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SDriftItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SCapacityQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SCapacityReportList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SCapacityReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*K8SCapacity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_k8s_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*K8SPod); i {
 			case 0:
 				return &v.state
@@ -1579,7 +4947,7 @@ func file_k8s_proto_init() {
 				return nil
 			}
 		}
-		file_k8s_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_k8s_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*K8SNode); i {
 			case 0:
 				return &v.state
@@ -1591,7 +4959,7 @@ func file_k8s_proto_init() {
 				return nil
 			}
 		}
-		file_k8s_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_k8s_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*K8SDeployment); i {
 			case 0:
 				return &v.state
@@ -1603,7 +4971,7 @@ func file_k8s_proto_init() {
 				return nil
 			}
 		}
-		file_k8s_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_k8s_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*K8SStatefulSet); i {
 			case 0:
 				return &v.state
@@ -1615,7 +4983,7 @@ func file_k8s_proto_init() {
 				return nil
 			}
 		}
-		file_k8s_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_k8s_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*K8SDaemonSet); i {
 			case 0:
 				return &v.state
@@ -1627,7 +4995,7 @@ func file_k8s_proto_init() {
 				return nil
 			}
 		}
-		file_k8s_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_k8s_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*K8SService); i {
 			case 0:
 				return &v.state
@@ -1639,7 +5007,7 @@ func file_k8s_proto_init() {
 				return nil
 			}
 		}
-		file_k8s_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_k8s_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*K8SNamespace); i {
 			case 0:
 				return &v.state
@@ -1651,7 +5019,7 @@ func file_k8s_proto_init() {
 				return nil
 			}
 		}
-		file_k8s_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_k8s_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*K8SNetworkPolicy); i {
 			case 0:
 				return &v.state
@@ -1669,8 +5037,8 @@ func file_k8s_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_k8s_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   20,
+			NumEnums:      6,
+			NumMessages:   71,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
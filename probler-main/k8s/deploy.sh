@@ -9,6 +9,8 @@ kubectl apply -f box.yaml
 sleep 2
 kubectl apply -f k8s.yaml
 sleep 2
+kubectl apply -f dcim.yaml
+sleep 2
 kubectl apply -f orm.yaml
 sleep 2
 #kubectl apply -f webui2.yaml